@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/transform"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	checksCmd.AddCommand(checksListCmd)
+	rootCmd.AddCommand(checksCmd)
+}
+
+var checksCmd = &cobra.Command{
+	Use:   "checks",
+	Short: "Manages checks run by the analysis",
+	Long: `Checks are transforms registered by name, default checks of the mode run
+unless disabled with --disable, other checks run when enabled with --enable.
+Executables named phronetic-check-<name> in --plugin-dir or in PATH are checks as well,
+they only run when enabled with --enable. They read the run context as JSON on stdin and write {"findings": [...]} as JSON on stdout`,
+}
+
+var checksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists available checks",
+	Long:  `Lists registered checks with the modes they run in and whether they run by default`,
+	Run: func(cmd *cobra.Command, args []string) {
+		transform.RegisterPlugins(env.Config().GetString("PluginDir"))
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(writer, "NAME\tMODES\tDEFAULT\tDESCRIPTION")
+		for _, check := range transform.Checks() {
+			modes := "All"
+			if len(check.Modes) > 0 {
+				modes = strings.Join(check.Modes, ",")
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%t\t%s\n", check.Name, modes, !check.Disabled, check.Description)
+		}
+		writer.Flush()
+	},
+	Args: cobra.MaximumNArgs(0),
+}
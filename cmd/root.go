@@ -15,11 +15,17 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
 	//Workaround go mod vendor issue 27063
 	_ "github.com/shurcooL/vfsgen"
 
 	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/profile"
 	"github.com/gildub/phronetic/pkg/transform"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -39,7 +45,7 @@ func init() {
 	env.Config().BindPFlag("MigrationCluster", rootCmd.PersistentFlags().Lookup("migration-cluster"))
 
 	// Flag for Differiential mode - Running by default in Migration mode
-	rootCmd.PersistentFlags().StringP("mode", "m", "", "Execution mode: source/destination differential or Migration (CAM Operator)")
+	rootCmd.PersistentFlags().StringP("mode", "m", "", "Execution mode: source/destination Differential, Migration (CAM Operator) or Verification of a run migration")
 	env.Config().BindPFlag("Mode", rootCmd.PersistentFlags().Lookup("mode"))
 
 	// MigPlan to search for
@@ -54,10 +60,62 @@ func init() {
 	rootCmd.PersistentFlags().StringP("destination-cluster", "t", "", "Destination cluster")
 	env.Config().BindPFlag("DestinationCluster", rootCmd.PersistentFlags().Lookup("destination-cluster"))
 
+	// Source cluster snapshot file, used in place of source cluster in Differential mode
+	rootCmd.PersistentFlags().String("source-snapshot", "", "Source cluster snapshot file path, replaces source cluster in Differential mode")
+	env.Config().BindPFlag("SourceSnapshot", rootCmd.PersistentFlags().Lookup("source-snapshot"))
+
+	// Destination cluster snapshot file, used in place of destination cluster in Differential mode
+	rootCmd.PersistentFlags().String("destination-snapshot", "", "Destination cluster snapshot file path, replaces destination cluster in Differential mode")
+	env.Config().BindPFlag("DestinationSnapshot", rootCmd.PersistentFlags().Lookup("destination-snapshot"))
+
+	// Reference profile of a known release, used in place of a destination cluster which doesn't exist yet in Differential mode
+	rootCmd.PersistentFlags().String("destination-profile", "", fmt.Sprintf("Reference profile replacing destination cluster in Differential mode, one of: %s", strings.Join(profile.Names(), ", ")))
+	env.Config().BindPFlag("DestinationProfile", rootCmd.PersistentFlags().Lookup("destination-profile"))
+
+	// Release deprecated APIs are looked for, destination cluster is then optional in Differential mode
+	rootCmd.PersistentFlags().String("target-version", "", "Kubernetes (1.x) or OpenShift (3.x, 4.x) version to look for deprecated APIs in, defaults to destination cluster version")
+	env.Config().BindPFlag("TargetVersion", rootCmd.PersistentFlags().Lookup("target-version"))
+
+	// Source namespaces to look for objects of resources which can't be migrated, in Differential mode
+	rootCmd.PersistentFlags().StringSlice("namespaces", nil, "Source namespaces to look for objects into in Differential mode, comma separated")
+	env.Config().BindPFlag("Namespaces", rootCmd.PersistentFlags().Lookup("namespaces"))
+
+	rootCmd.PersistentFlags().String("namespace-selector", "", "Label selector of source namespaces to look for objects into in Differential mode")
+	env.Config().BindPFlag("NamespaceSelector", rootCmd.PersistentFlags().Lookup("namespace-selector"))
+
+	rootCmd.PersistentFlags().Bool("all-namespaces", false, "Look for objects into all source namespaces in Differential mode")
+	env.Config().BindPFlag("AllNamespaces", rootCmd.PersistentFlags().Lookup("all-namespaces"))
+
 	// Don't output logs to console if true
 	rootCmd.PersistentFlags().BoolP("silent", "s", false, "silent mode, disable logging output to console")
 	env.Config().BindPFlag("Silent", rootCmd.PersistentFlags().Lookup("silent"))
 
+	// Never prompt, missing values are reported as a validation error
+	rootCmd.PersistentFlags().Bool("non-interactive", false, "non-interactive mode, fail with all missing values instead of prompting them")
+	env.Config().BindPFlag("NonInteractive", rootCmd.PersistentFlags().Lookup("non-interactive"))
+
+	// Report formats, each one is written to its own file in work directory
+	rootCmd.PersistentFlags().StringSlice("output", reportoutput.DefaultFormats, fmt.Sprintf("Report formats, comma separated list of: %s", strings.Join(reportoutput.Formats(), ", ")))
+	env.Config().BindPFlag("Output", rootCmd.PersistentFlags().Lookup("output"))
+
+	// Transforms run concurrently, each one within a time limit
+	rootCmd.PersistentFlags().Int("workers", transform.DefaultWorkers, "Maximum number of transforms run concurrently")
+	env.Config().BindPFlag("Workers", rootCmd.PersistentFlags().Lookup("workers"))
+
+	rootCmd.PersistentFlags().Duration("transform-timeout", transform.DefaultTimeout, "Time limit of each transform, 0 for none")
+	env.Config().BindPFlag("TransformTimeout", rootCmd.PersistentFlags().Lookup("transform-timeout"))
+
+	// Checks are selected by name, see "phronetic checks list"
+	rootCmd.PersistentFlags().StringSlice("enable", nil, "Checks to run in addition to default ones, comma separated")
+	env.Config().BindPFlag("Enable", rootCmd.PersistentFlags().Lookup("enable"))
+
+	rootCmd.PersistentFlags().StringSlice("disable", nil, "Default checks not to run, comma separated")
+	env.Config().BindPFlag("Disable", rootCmd.PersistentFlags().Lookup("disable"))
+
+	// External check plugins are searched in this directory, then in PATH
+	rootCmd.PersistentFlags().String("plugin-dir", "", "Directory of phronetic-check-* plugin executables, searched before PATH")
+	env.Config().BindPFlag("PluginDir", rootCmd.PersistentFlags().Lookup("plugin-dir"))
+
 	// Get config file from an save to viper config
 	rootCmd.PersistentFlags().StringP("work-dir", "w", "", "set application data working directory (Default \".\")")
 	env.Config().BindPFlag("WorkDir", rootCmd.PersistentFlags().Lookup("work-dir"))
@@ -71,15 +129,27 @@ var rootCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		env.InitLogger()
 
-		if err := env.InitConfig(); err != nil {
-			logrus.Fatal(err)
+		session, err := env.InitConfig()
+		if err != nil {
+			exitWithError(err)
 		}
 
-		transform.Start()
+		if err := transform.Start(session); err != nil {
+			exitWithError(err)
+		}
 	},
 	Args: cobra.MaximumNArgs(0),
 }
 
+// exitWithError logs err and exits, using a distinct exit code for invalid configuration
+func exitWithError(err error) {
+	if env.IsValidationError(err) {
+		logrus.Error(err)
+		os.Exit(env.ValidationExitCode)
+	}
+	logrus.Fatal(err)
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // It only needs to happen once.
 func Execute() {
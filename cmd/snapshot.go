@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/io"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	// Cluster name for Kubeconfig context
+	snapshotCmd.Flags().String("cluster", "", "Cluster to capture API discovery data from")
+	env.Config().BindPFlag("SnapshotCluster", snapshotCmd.Flags().Lookup("cluster"))
+
+	// Snapshot file name
+	snapshotCmd.Flags().String("file", "", "Snapshot file name, written into work directory (Default \"<cluster>-snapshot.json\")")
+	env.Config().BindPFlag("SnapshotFile", snapshotCmd.Flags().Lookup("file"))
+
+	rootCmd.AddCommand(snapshotCmd)
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Captures cluster API discovery data to a file",
+	Long: `Captures API groups, versions, resources, scope, verbs and preferred versions of a cluster
+to a snapshot file in work directory and logs its path. The file can replace the cluster
+in Differential mode by passing that path to --source-snapshot or --destination-snapshot`,
+	Run: func(cmd *cobra.Command, args []string) {
+		env.InitLogger()
+
+		cluster, err := env.InitSnapshotConfig()
+		if err != nil {
+			exitWithError(err)
+		}
+
+		if err := writeSnapshot(cluster); err != nil {
+			logrus.Fatal(err)
+		}
+	},
+	Args: cobra.MaximumNArgs(0),
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+func writeSnapshot(cluster *api.Cluster) error {
+	snapshot, err := cluster.Discover()
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(snapshot, "", " ")
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal snapshot")
+	}
+
+	fileName := env.Config().GetString("SnapshotFile")
+	if fileName == "" {
+		fileName = fmt.Sprintf("%s-snapshot.json", unsafeFileNameChars.ReplaceAllString(snapshot.ClusterName, "-"))
+	}
+
+	// The logged path can be passed as is to --source-snapshot or --destination-snapshot
+	if err := io.WriteFile(content, fileName); err != nil {
+		return errors.Wrapf(err, "unable to write to snapshot file: %s", io.FilePath(fileName))
+	}
+
+	logrus.Infof("Snapshot:Added: %s", io.FilePath(fileName))
+	return nil
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSnapshot(t *testing.T) {
+	workDir, err := ioutil.TempDir("", "phronetic-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(workDir)
+
+	env.Config().Set("WorkDir", workDir)
+	defer env.Config().Set("WorkDir", nil)
+
+	cluster := api.NewSnapshotCluster(&api.Snapshot{Version: "v1", ClusterName: "cluster1.example.com:8443", ServerVersion: "v1.11.0"})
+	require.NoError(t, writeSnapshot(cluster))
+
+	// The logged path is the one loaded with --source-snapshot
+	path := io.FilePath("cluster1.example.com-8443-snapshot.json")
+	assert.Equal(t, filepath.Join(workDir, "cluster1.example.com-8443-snapshot.json"), path)
+
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	snapshot, err := api.LoadSnapshot(content)
+	require.NoError(t, err)
+	assert.Equal(t, "cluster1.example.com:8443", snapshot.ClusterName)
+}
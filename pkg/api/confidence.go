@@ -0,0 +1,62 @@
+package api
+
+import (
+	"fmt"
+)
+
+// Confidence is the level of confidence a report item can be migrated with
+type Confidence int
+
+const (
+	// NoConfidence represents report items we can not migrate
+	NoConfidence Confidence = iota
+
+	// ModerateConfidence represents report items we can migrate with caveats
+	ModerateConfidence
+
+	// HighConfidence represents report items we can migrate without issue
+	HighConfidence
+)
+
+var confidenceNames = map[Confidence]string{
+	NoConfidence:       "None",
+	ModerateConfidence: "Moderate",
+	HighConfidence:     "High",
+}
+
+func (c Confidence) String() string {
+	if name, ok := confidenceNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("Confidence(%d)", int(c))
+}
+
+// MarshalText encodes confidence with its name
+func (c Confidence) MarshalText() ([]byte, error) {
+	if _, ok := confidenceNames[c]; !ok {
+		return nil, fmt.Errorf("invalid confidence %d", int(c))
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes confidence from its name
+func (c *Confidence) UnmarshalText(text []byte) error {
+	for confidence, name := range confidenceNames {
+		if name == string(text) {
+			*c = confidence
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid confidence %q", string(text))
+}
+
+// LowestConfidence returns the lowest of provided confidences, HighConfidence when none is provided
+func LowestConfidence(confidences ...Confidence) Confidence {
+	lowest := HighConfidence
+	for _, confidence := range confidences {
+		if confidence < lowest {
+			lowest = confidence
+		}
+	}
+	return lowest
+}
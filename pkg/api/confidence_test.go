@@ -0,0 +1,41 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfidenceJSON(t *testing.T) {
+	testCases := []struct {
+		confidence Confidence
+		expected   string
+	}{
+		{confidence: NoConfidence, expected: `"None"`},
+		{confidence: ModerateConfidence, expected: `"Moderate"`},
+		{confidence: HighConfidence, expected: `"High"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			content, err := json.Marshal(tc.confidence)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(content))
+
+			var confidence Confidence
+			require.NoError(t, json.Unmarshal(content, &confidence))
+			assert.Equal(t, tc.confidence, confidence)
+		})
+	}
+
+	var confidence Confidence
+	assert.Error(t, json.Unmarshal([]byte(`"Low"`), &confidence))
+}
+
+func TestLowestConfidence(t *testing.T) {
+	assert.Equal(t, HighConfidence, LowestConfidence())
+	assert.Equal(t, ModerateConfidence, LowestConfidence(HighConfidence, ModerateConfidence))
+	assert.Equal(t, NoConfidence, LowestConfidence(ModerateConfidence, NoConfidence, HighConfidence))
+}
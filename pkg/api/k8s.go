@@ -1,8 +1,11 @@
 package api
 
 import (
-	"log"
+	"net/http"
 
+	"github.com/pkg/errors"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -10,22 +13,60 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// NewK8SOrDie init k8s client or panic
-func NewK8SOrDie(config *rest.Config) *kubernetes.Clientset {
-	return kubernetes.NewForConfigOrDie(config)
+// NewK8S init k8s client
+func NewK8S(config *rest.Config) (kubernetes.Interface, error) {
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't create k8s api client")
+	}
+
+	return k8sClient, nil
+}
+
+// NewCtrlClient gets a controller client
+func NewCtrlClient(config *rest.Config, options client.Options) (client.Client, error) {
+	ctrlClient, err := client.New(config, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't create runtime-controller client")
+	}
+
+	return ctrlClient, nil
 }
 
-// NewCtrlClientorDie gets a controller client or die
-func NewCtrlClientorDie(config *rest.Config, options client.Options) client.Client {
-	ctrlClient, error := client.New(config, options)
-	if error != nil {
-		log.Fatal("Can't create runtime-controller client")
+// NewK8SDynClient init k8s dynamic client
+func NewK8SDynClient(config *rest.Config) (dynamic.Interface, error) {
+	dynClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't create k8s api dynamic client")
 	}
 
-	return ctrlClient
+	return dynClient, nil
+}
+
+// metadataAccept asks for objects metadata only, servers not supporting it send full objects
+const metadataAccept = "application/json;as=PartialObjectMetadataList;v=v1beta1;g=meta.k8s.io,application/json"
+
+// NewK8SMetadataClient init k8s dynamic client which only gets objects metadata when listing
+func NewK8SMetadataClient(config *rest.Config) (dynamic.Interface, error) {
+	metadataConfig := rest.CopyConfig(config)
+	wrapTransport := metadataConfig.WrapTransport
+	metadataConfig.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrapTransport != nil {
+			rt = wrapTransport(rt)
+		}
+		return metadataRoundTripper{rt}
+	}
+
+	return NewK8SDynClient(metadataConfig)
+}
+
+// metadataRoundTripper replaces the Accept header of requests
+type metadataRoundTripper struct {
+	rt http.RoundTripper
 }
 
-// NewK8SDynClientOrDie init k8s client or panic
-func NewK8SDynClientOrDie(config *rest.Config) dynamic.Interface {
-	return dynamic.NewForConfigOrDie(config)
+func (m metadataRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = utilnet.CloneRequest(req)
+	req.Header.Set("Accept", metadataAccept)
+	return m.rt.RoundTrip(req)
 }
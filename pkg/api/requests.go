@@ -2,65 +2,77 @@ package api
 
 import (
 	"context"
+	"sort"
 
 	migv1alpha1 "github.com/fusor/mig-controller/pkg/apis/migration/v1alpha1"
-	"github.com/sirupsen/logrus"
+	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/restmapper"
 
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-var listOptions metav1.ListOptions
 var getOptions metav1.GetOptions
 
-// RESTMapperGetGRs lists all GVKs for a resource
-func RESTMapperGetGRs(client *kubernetes.Clientset) meta.RESTMapper {
-	groupResources, err := restmapper.GetAPIGroupResources(client.Discovery())
-	if err != nil {
-		logrus.Fatal(err)
-	}
-	return restmapper.NewDiscoveryRESTMapper(groupResources)
-}
-
 // GetKindsFor lists all GVKs for a resource
-func GetKindsFor(restMapper meta.RESTMapper, resource string) []schema.GroupVersionKind {
+func GetKindsFor(restMapper meta.RESTMapper, resource string) ([]schema.GroupVersionKind, error) {
 	gvr := schema.GroupVersionResource{Group: "", Version: "", Resource: resource}
 	gvks, err := restMapper.KindsFor(gvr)
 	if err != nil {
-		logrus.Fatal(err)
+		return nil, errors.Wrapf(err, "unable to get kinds for resource %s", resource)
 	}
-	return gvks
+	return gvks, nil
 }
 
-// ListServerResources list all resources
-func ListServerResources(client *kubernetes.Clientset) []*metav1.APIResourceList {
+// ListServerResources list all resources.
+// Group versions failing discovery, such as unavailable aggregated APIs, are returned as discovery errors
+// along with the resources of the other group versions.
+func ListServerResources(client discovery.ServerResourcesInterface) ([]*metav1.APIResourceList, []DiscoveryError, error) {
 	resources, err := client.ServerResources()
-	if err != nil {
-		logrus.Fatal(err)
+	if err == nil {
+		return resources, nil, nil
+	}
+
+	groupDiscoveryFailed, ok := err.(*discovery.ErrGroupDiscoveryFailed)
+	if !ok {
+		return nil, nil, errors.Wrap(err, "unable to list server resources")
 	}
-	return resources
+
+	discoveryErrors := []DiscoveryError{}
+	for groupVersion, groupErr := range groupDiscoveryFailed.Groups {
+		discoveryErrors = append(discoveryErrors, DiscoveryError{
+			GroupVersion: groupVersion.String(),
+			Message:      groupErr.Error(),
+		})
+	}
+	sort.Slice(discoveryErrors, func(i, j int) bool {
+		return discoveryErrors[i].GroupVersion < discoveryErrors[j].GroupVersion
+	})
+
+	return resources, discoveryErrors, nil
 }
 
 // GetMigCluster get MigrationCluster
-func GetMigCluster(client ctrlclient.Client, name string) migv1alpha1.MigCluster {
+func GetMigCluster(client ctrlclient.Client, name string) (migv1alpha1.MigCluster, error) {
 	objectKey := types.NamespacedName{
 		Namespace: "openshift-migration",
 		Name:      name,
 	}
 
 	migCluster := migv1alpha1.MigCluster{}
-	err := client.Get(context.TODO(), objectKey, &migCluster)
-	if err != nil {
-		logrus.Fatal(err)
+	if err := client.Get(context.TODO(), objectKey, &migCluster); err != nil {
+		return migCluster, errors.Wrapf(err, "unable to get MigCluster %s", name)
 	}
-	return migCluster
+	return migCluster, nil
 }
 
 // GetMigPlan get MigrationPlan
@@ -76,10 +88,85 @@ func GetMigPlan(client ctrlclient.Client, name string) (migv1alpha1.MigPlan, err
 }
 
 // GetNamespace get namespace
-func GetNamespace(client *kubernetes.Clientset, name string) *corev1.Namespace {
+func GetNamespace(client kubernetes.Interface, name string) (*corev1.Namespace, error) {
 	namespace, err := client.CoreV1().Namespaces().Get(name, getOptions)
 	if err != nil {
-		logrus.Fatal(err)
+		return nil, errors.Wrapf(err, "unable to get namespace %s", name)
+	}
+	return namespace, nil
+}
+
+// ListNamespaceNames returns names of namespaces matching the label selector, all namespaces when it's empty
+func ListNamespaceNames(client kubernetes.Interface, selector string) ([]string, error) {
+	names := []string{}
+	options := metav1.ListOptions{LabelSelector: selector, Limit: 500}
+	for {
+		list, err := client.CoreV1().Namespaces().List(options)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list namespaces matching %q", selector)
+		}
+
+		for _, namespace := range list.Items {
+			names = append(names, namespace.Name)
+		}
+
+		if options.Continue = list.Continue; options.Continue == "" {
+			return names, nil
+		}
+	}
+}
+
+// ListObjectNames returns names of the objects of a resource in a namespace, listed by pages of pageSize objects
+func ListObjectNames(client dynamic.Interface, gvr schema.GroupVersionResource, namespace string, pageSize int64) ([]string, error) {
+	names := []string{}
+	options := metav1.ListOptions{Limit: pageSize}
+	for {
+		list, err := client.Resource(gvr).Namespace(namespace).List(options)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list %s in namespace %s", gvr.String(), namespace)
+		}
+
+		for _, object := range list.Items {
+			names = append(names, object.GetName())
+		}
+
+		if options.Continue = list.GetContinue(); options.Continue == "" {
+			return names, nil
+		}
+	}
+}
+
+// ListObjects returns the objects of a resource in a namespace, listed by pages of pageSize objects.
+// Cluster-scoped resources are listed with an empty namespace.
+func ListObjects(client dynamic.Interface, gvr schema.GroupVersionResource, namespace string, pageSize int64) ([]unstructured.Unstructured, error) {
+	objects := []unstructured.Unstructured{}
+	options := metav1.ListOptions{Limit: pageSize}
+	for {
+		list, err := client.Resource(gvr).Namespace(namespace).List(options)
+		if err != nil {
+			if namespace == "" {
+				return nil, errors.Wrapf(err, "unable to list %s", gvr.String())
+			}
+			return nil, errors.Wrapf(err, "unable to list %s in namespace %s", gvr.String(), namespace)
+		}
+
+		objects = append(objects, list.Items...)
+
+		if options.Continue = list.GetContinue(); options.Continue == "" {
+			return objects, nil
+		}
+	}
+}
+
+// ListCustomResourceDefinitions returns the CRDs of a cluster, from apiextensions.k8s.io/v1 when served, from v1beta1 otherwise
+func ListCustomResourceDefinitions(client dynamic.Interface, pageSize int64) ([]unstructured.Unstructured, error) {
+	for _, version := range []string{"v1", "v1beta1"} {
+		gvr := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: version, Resource: "customresourcedefinitions"}
+		objects, err := ListObjects(client, gvr, "", pageSize)
+		if apierrors.IsNotFound(errors.Cause(err)) {
+			continue
+		}
+		return objects, err
 	}
-	return namespace
+	return nil, errors.New("unable to list customresourcedefinitions, apiextensions.k8s.io is not served")
 }
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+type fakeServerResources struct {
+	discovery.ServerResourcesInterface
+	resources []*metav1.APIResourceList
+	err       error
+}
+
+func (f fakeServerResources) ServerResources() ([]*metav1.APIResourceList, error) {
+	return f.resources, f.err
+}
+
+func TestListServerResources(t *testing.T) {
+	resources := []*metav1.APIResourceList{{GroupVersion: "v1"}}
+
+	testCases := []struct {
+		name                    string
+		err                     error
+		expectedResources       []*metav1.APIResourceList
+		expectedDiscoveryErrors []DiscoveryError
+		expectedErr             bool
+	}{
+		{
+			name:              "complete discovery",
+			expectedResources: resources,
+		},
+		{
+			name: "partial discovery",
+			err: &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{
+				{Group: "metrics.k8s.io", Version: "v1beta1"}:   errors.New("the server is currently unable to handle the request"),
+				{Group: "custom.metrics.k8s.io", Version: "v1"}: errors.New("service unavailable"),
+			}},
+			expectedResources: resources,
+			expectedDiscoveryErrors: []DiscoveryError{
+				{GroupVersion: "custom.metrics.k8s.io/v1", Message: "service unavailable"},
+				{GroupVersion: "metrics.k8s.io/v1beta1", Message: "the server is currently unable to handle the request"},
+			},
+		},
+		{
+			name:        "failed discovery",
+			err:         errors.New("connection refused"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualResources, discoveryErrors, err := ListServerResources(fakeServerResources{resources: resources, err: tc.err})
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResources, actualResources)
+			assert.Equal(t, tc.expectedDiscoveryErrors, discoveryErrors)
+		})
+	}
+}
+
+func TestListObjectNames(t *testing.T) {
+	pages := map[string]string{
+		"":      `{"kind": "PartialObjectMetadataList", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {"continue": "page2"}, "items": [{"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {"name": "backup"}}, {"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {"name": "cleanup"}}]}`,
+		"page2": `{"kind": "PartialObjectMetadataList", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {}, "items": [{"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {"name": "report"}}]}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apis/batch/v1beta1/namespaces/app1/cronjobs", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		assert.Contains(t, r.Header.Get("Accept"), "as=PartialObjectMetadataList")
+
+		page, ok := pages[r.URL.Query().Get("continue")]
+		if !ok {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	client, err := NewK8SMetadataClient(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	gvr := schema.GroupVersionResource{Group: "batch", Version: "v1beta1", Resource: "cronjobs"}
+	names, err := ListObjectNames(client, gvr, "app1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup", "cleanup", "report"}, names)
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"sync"
+
+	migv1alpha1 "github.com/fusor/mig-controller/pkg/apis/migration/v1alpha1"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Session holds the clusters and migration plan an analysis runs against.
+// Sessions are independent from each other so several analyses can run in the same process.
+// A session must not be modified once its analysis started: transforms read it concurrently
+// and a transform given up on after a timeout may keep reading it. Cluster discovery data
+// is the exception, it's captured by Cluster.Discover which is safe for concurrent use.
+type Session struct {
+	// Mode is the operational mode: Migration, Differential or Verification
+	Mode        string
+	Source      *Cluster
+	Destination *Cluster
+	// MigPlan is the migration plan to analyse in Migration and Verification modes
+	MigPlan *migv1alpha1.MigPlan
+	// CtrlClient is the controller client of the migration cluster
+	CtrlClient client.Client
+	// NamespaceScope selects source namespaces looked into in Differential mode
+	NamespaceScope NamespaceScope
+}
+
+// NamespaceScope selects namespaces by name, by label selector or all of them
+type NamespaceScope struct {
+	Names    []string
+	Selector string
+	All      bool
+}
+
+// IsEmpty returns true when no namespace is selected
+func (s NamespaceScope) IsEmpty() bool {
+	return len(s.Names) == 0 && s.Selector == "" && !s.All
+}
+
+// Cluster holds the api clients and discovery data of a cluster
+type Cluster struct {
+	// Name is the name of the cluster in KUBECONFIG or in the snapshot
+	Name string
+	// Context is the KUBECONFIG context used to access the cluster, empty when created otherwise
+	Context   string
+	Client    kubernetes.Interface
+	DynClient dynamic.Interface
+	// MetadataClient is a dynamic client which only gets objects metadata when listing
+	MetadataClient dynamic.Interface
+	// Snapshot is the cluster discovery data, captured from the live cluster unless provided
+	Snapshot *Snapshot
+	// RESTMapper is built from the cluster discovery data
+	RESTMapper meta.RESTMapper
+	// Profile is the name of the reference profile standing for a cluster which doesn't exist, empty for a real cluster
+	Profile string
+
+	// discoverMutex serializes discovery so concurrent transforms capture it once
+	discoverMutex sync.Mutex
+}
+
+// NewCluster creates api clients for the cluster of a KUBECONFIG context
+func NewCluster(kubeConfig *clientcmdapi.Config, contextName string) (*Cluster, error) {
+	config, err := buildConfig(kubeConfig, contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := NewClusterFromConfig(kubeConfig.Contexts[contextName].Cluster, config)
+	if err != nil {
+		return nil, err
+	}
+	cluster.Context = contextName
+	return cluster, nil
+}
+
+// NewClusterFromConfig creates api clients for a cluster using its REST config
+func NewClusterFromConfig(name string, config *rest.Config) (*Cluster, error) {
+	k8sClient, err := NewK8S(config)
+	if err != nil {
+		return nil, err
+	}
+
+	dynClient, err := NewK8SDynClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataClient, err := NewK8SMetadataClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("Kubernetes API clients initialized for %s", name)
+
+	return &Cluster{
+		Name:           name,
+		Client:         k8sClient,
+		DynClient:      dynClient,
+		MetadataClient: metadataClient,
+	}, nil
+}
+
+// NewSnapshotCluster creates a cluster from its snapshot, such cluster has no api client
+func NewSnapshotCluster(snapshot *Snapshot) *Cluster {
+	return &Cluster{
+		Name:       snapshot.ClusterName,
+		Snapshot:   snapshot,
+		RESTMapper: snapshot.RESTMapper(),
+	}
+}
+
+// IsLive returns true when the cluster has api clients, false for a snapshot or a reference profile
+func (c *Cluster) IsLive() bool {
+	return c.DynClient != nil
+}
+
+// Discover returns the cluster discovery data, it's captured from the live cluster once.
+// It's safe for concurrent use, Snapshot and RESTMapper can be read once it returned.
+func (c *Cluster) Discover() (*Snapshot, error) {
+	c.discoverMutex.Lock()
+	defer c.discoverMutex.Unlock()
+
+	if c.Snapshot == nil {
+		snapshot, err := NewSnapshot(c.Client.Discovery(), c.Name)
+		if err != nil {
+			return nil, err
+		}
+		c.Snapshot = snapshot
+		c.RESTMapper = snapshot.RESTMapper()
+	}
+
+	return c.Snapshot, nil
+}
+
+// NewMigrationCtrlClient creates a runtime-controller client for migration resources using a KUBECONFIG context
+func NewMigrationCtrlClient(kubeConfig *clientcmdapi.Config, contextName string) (client.Client, error) {
+	config, err := buildConfig(kubeConfig, contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrlClient, err := NewMigrationCtrlClientFromConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("Kubernetes Controller client initialized for %s", contextName)
+	return ctrlClient, nil
+}
+
+// NewMigrationCtrlClientFromConfig creates a runtime-controller client for migration resources using a REST config
+func NewMigrationCtrlClientFromConfig(config *rest.Config) (client.Client, error) {
+	crScheme := k8sruntime.NewScheme()
+	migv1alpha1.AddToScheme(crScheme)
+	return NewCtrlClient(config, client.Options{Scheme: crScheme})
+}
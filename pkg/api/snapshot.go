@@ -0,0 +1,128 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/restmapper"
+)
+
+// SnapshotVersion is the version of the snapshot file format
+const SnapshotVersion = "v1"
+
+// Snapshot holds the discovery data of a cluster: API groups with their versions
+// and preferred version, and resources with their scope and verbs
+type Snapshot struct {
+	Version           string                    `json:"version"`
+	ClusterName       string                    `json:"clusterName"`
+	ServerVersion     string                    `json:"serverVersion,omitempty"`
+	CreationTimestamp metav1.Time               `json:"creationTimestamp"`
+	Groups            []metav1.APIGroup         `json:"groups"`
+	Resources         []*metav1.APIResourceList `json:"resources"`
+	DiscoveryErrors   []DiscoveryError          `json:"discoveryErrors,omitempty"`
+}
+
+// DiscoveryError holds a group version whose resources couldn't be discovered
+type DiscoveryError struct {
+	GroupVersion string `json:"groupVersion"`
+	Message      string `json:"message"`
+}
+
+// NewSnapshot captures discovery data of a cluster
+func NewSnapshot(client discovery.DiscoveryInterface, clusterName string) (*Snapshot, error) {
+	groups, err := client.ServerGroups()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't discover API groups of %s", clusterName)
+	}
+
+	resources, discoveryErrors, err := ListServerResources(client)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't discover API resources of %s", clusterName)
+	}
+
+	for _, discoveryError := range discoveryErrors {
+		logrus.Warnf("Partial discovery of %s, skipping %s: %s", clusterName, discoveryError.GroupVersion, discoveryError.Message)
+	}
+
+	// Kubernetes version is only used to look for deprecated APIs, the snapshot is still usable without it
+	serverVersion := ""
+	if version, err := client.ServerVersion(); err != nil {
+		logrus.Warnf("Can't get Kubernetes version of %s: %s", clusterName, err)
+	} else {
+		serverVersion = version.GitVersion
+	}
+
+	return &Snapshot{
+		Version:           SnapshotVersion,
+		ClusterName:       clusterName,
+		ServerVersion:     serverVersion,
+		CreationTimestamp: metav1.Now(),
+		Groups:            groups.Groups,
+		Resources:         resources,
+		DiscoveryErrors:   discoveryErrors,
+	}, nil
+}
+
+// LoadSnapshot parses the content of a snapshot file
+func LoadSnapshot(content []byte) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(content, snapshot); err != nil {
+		return nil, errors.Wrap(err, "Can't parse snapshot")
+	}
+
+	if snapshot.Version != SnapshotVersion {
+		return nil, errors.New(fmt.Sprintf("Unsupported snapshot version %q, expected %q", snapshot.Version, SnapshotVersion))
+	}
+
+	return snapshot, nil
+}
+
+// RESTMapper builds a RESTMapper from the snapshot discovery data
+func (s *Snapshot) RESTMapper() meta.RESTMapper {
+	versionedResources := make(map[schema.GroupVersion][]metav1.APIResource)
+	for _, resourceList := range s.Resources {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		versionedResources[gv] = resourceList.APIResources
+	}
+
+	groupResources := []*restmapper.APIGroupResources{}
+	for _, group := range s.Groups {
+		groupResource := &restmapper.APIGroupResources{
+			Group:              group,
+			VersionedResources: make(map[string][]metav1.APIResource),
+		}
+		for _, version := range group.Versions {
+			gv := schema.GroupVersion{Group: group.Name, Version: version.Version}
+			if resources, ok := versionedResources[gv]; ok {
+				groupResource.VersionedResources[version.Version] = resources
+			}
+		}
+		groupResources = append(groupResources, groupResource)
+	}
+
+	return restmapper.NewDiscoveryRESTMapper(groupResources)
+}
+
+// PreferredVersions returns preferred version of each API group keyed by GroupKey
+func (s *Snapshot) PreferredVersions() map[string]string {
+	return preferredVersions(s.Groups)
+}
+
+// preferredVersions returns preferred version of each API group keyed by GroupKey
+func preferredVersions(groups []metav1.APIGroup) map[string]string {
+	preferredVersions := make(map[string]string)
+	for _, group := range groups {
+		preferredVersions[GroupKey(group.Name)] = group.PreferredVersion.Version
+	}
+	return preferredVersions
+}
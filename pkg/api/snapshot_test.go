@@ -0,0 +1,58 @@
+package api
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestLoadSnapshot(t *testing.T) {
+	content, err := ioutil.ReadFile("testdata/snapshot.json")
+	require.NoError(t, err)
+
+	snapshot, err := LoadSnapshot(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, "cluster1-example-com:8443", snapshot.ClusterName)
+	assert.Equal(t, map[string]string{"core": "v1", "apps": "v1"}, snapshot.PreferredVersions())
+
+	testCases := []struct {
+		name         string
+		resource     string
+		expectedGVKs []schema.GroupVersionKind
+	}{
+		{
+			name:     "core group resource",
+			resource: "pods",
+			expectedGVKs: []schema.GroupVersionKind{
+				{Group: "", Version: "v1", Kind: "Pod"},
+			},
+		},
+		{
+			name:     "resource served with several versions",
+			resource: "deployments",
+			expectedGVKs: []schema.GroupVersionKind{
+				{Group: "apps", Version: "v1", Kind: "Deployment"},
+				{Group: "apps", Version: "v1beta1", Kind: "Deployment"},
+			},
+		},
+	}
+
+	restMapper := snapshot.RESTMapper()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gvks, err := GetKindsFor(restMapper, tc.resource)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedGVKs, gvks)
+		})
+	}
+}
+
+func TestLoadSnapshotVersion(t *testing.T) {
+	_, err := LoadSnapshot([]byte(`{"version": "v0"}`))
+	assert.Error(t, err)
+}
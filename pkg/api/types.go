@@ -1,14 +1,28 @@
 package api
 
 import (
+	"github.com/sirupsen/logrus"
+
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// CoreGroup is the key used for the legacy core API group, which has an empty group name
+const CoreGroup = "core"
+
+// GroupKey returns the key used to index an API group in RGVKs
+func GroupKey(group string) string {
+	if group == "" {
+		return CoreGroup
+	}
+	return group
+}
+
 /*
 Resources store GVKs
 RGVKs is short for Resource -> GroupVersionKind
 RGVKs represents resources broken down by group and their containing GVKs
 Eaach list is built up and filtered down from k8s/client-go/restmapper.GetAPIGroupResources(client.Discovery())
+The legacy core group (empty group name) is keyed as "core" while its GVKs keep the empty group.
 A map[string]map[string][]schema.GroupVersionKind For example:
 [
 	"cronjobs": [
@@ -20,6 +34,15 @@ A map[string]map[string][]schema.GroupVersionKind For example:
 			},
 		],
 	],
+	"pods": [
+		"core": [
+			{
+				Group: "",
+				Version: "v1",
+				Kind: "Pod",
+			},
+		],
+	],
 	"localsubjectaccessreviews": [
 		"authorization.k8s.io": [
 			{
@@ -53,6 +76,12 @@ A map[string]map[string][]schema.GroupVersionKind For example:
 ]
 */
 type Resources struct {
+	// SrcClusterName is the name of source cluster
+	SrcClusterName string
+	// DstClusterName is the name of destination cluster
+	DstClusterName string
+	// DstProfile is the name of the reference profile standing for destination cluster, if any
+	DstProfile   string
 	ResourceList []Resource
 	// SrcRGVKs contains all RGVKs available on source api-server (trimmed of "/.*"" suffixes)
 	SrcRGVKs map[string]map[string][]schema.GroupVersionKind
@@ -64,13 +93,91 @@ type Resources struct {
 	SrcGapRGVKs map[string]map[string][]schema.GroupVersionKind
 	// DstGapRGVKs contains RGVKs where group is in both source and destination api-servers but version(s) are only in dst
 	DstGapRGVKs map[string]map[string][]schema.GroupVersionKind
+	// SrcClusterRGVKs contains all cluster-scoped RGVKs available on source api-server
+	SrcClusterRGVKs map[string]map[string][]schema.GroupVersionKind
+	// DstClusterRGVKs contains all cluster-scoped RGVKs available on destination api-server
+	DstClusterRGVKs map[string]map[string][]schema.GroupVersionKind
+	// SrcOnlyClusterRGs contains cluster-scoped resources and the API Group only available on source api-server
+	SrcOnlyClusterRGs map[string]map[string][]schema.GroupVersionKind
+	// SrcGapClusterRGVKs contains cluster-scoped RGVKs where group is in both api-servers but version(s) are only in src
+	SrcGapClusterRGVKs map[string]map[string][]schema.GroupVersionKind
+	// DstGapClusterRGVKs contains cluster-scoped RGVKs where group is in both api-servers but version(s) are only in dst
+	DstGapClusterRGVKs map[string]map[string][]schema.GroupVersionKind
+	// SrcPreferredVersions contains preferred version of each API group on source api-server
+	SrcPreferredVersions map[string]string
+	// DstPreferredVersions contains preferred version of each API group on destination api-server
+	DstPreferredVersions map[string]string
+	// UnservedPreferredRGVKs contains source preferred GVK of resources not served by destination api-server
+	UnservedPreferredRGVKs map[string]map[string]schema.GroupVersionKind
+	// UnservedPreferredClusterRGVKs contains source preferred GVK of cluster-scoped resources not served by destination api-server
+	UnservedPreferredClusterRGVKs map[string]map[string]schema.GroupVersionKind
+	// Relocations contains resources only available on source api-server within their group
+	// but whose Kind is served by destination api-server within another group
+	Relocations []Relocation
+	// ClusterRelocations contains cluster-scoped resources whose Kind moved to another group
+	ClusterRelocations []Relocation
+	// Namespaces contains the namespaces to migrate
+	Namespaces []string
+	// NamespaceUsage contains, for resources which can't be migrated with high confidence,
+	// the objects of the resource in each namespace having some, broken down by resource and group
+	NamespaceUsage map[string]map[string][]NamespaceUsage
+	// SrcDiscoveryErrors contains group versions which failed discovery on source api-server
+	SrcDiscoveryErrors []DiscoveryError
+	// DstDiscoveryErrors contains group versions which failed discovery on destination api-server
+	DstDiscoveryErrors []DiscoveryError
+	// Errors contains errors which didn't prevent to report, such as a missing namespace or a broken API group
+	Errors []string
+}
+
+// AddError records an error which didn't prevent to report
+func (r *Resources) AddError(err error) {
+	logrus.Warn(err)
+	r.Errors = append(r.Errors, err.Error())
+}
+
+// Relocation holds a resource whose Kind moved to another API group,
+// for instance extensions/v1beta1 Deployment moved to apps/v1
+type Relocation struct {
+	ResourceName string
+	// Source contains GVKs of the group only available on source
+	Source []schema.GroupVersionKind
+	// Destination contains GVKs of the same Kind available on destination in other groups
+	Destination []schema.GroupVersionKind
+	// Target is the destination GVK which can receive the objects
+	Target schema.GroupVersionKind
 }
 
 // Resource holds support information for a resource
 type Resource struct {
-	ResourceName  string
-	Source        []schema.GroupVersionKind
-	Destination   []schema.GroupVersionKind
-	NamespaceList []string
-	Support       string
+	ResourceName        string
+	Source              []schema.GroupVersionKind
+	Destination         []schema.GroupVersionKind
+	SrcPreferredVersion string
+	DstPreferredVersion string
+	NamespaceList       []string
+	Confidence          Confidence
+	Reason              string
+	// Usage contains the objects of the resource in each namespace of NamespaceList
+	Usage []NamespaceUsage
+}
+
+// NamespaceUsage holds the objects of a resource in a namespace
+type NamespaceUsage struct {
+	Namespace string `json:"namespace"`
+	Count     int    `json:"count"`
+	// Objects contains the names of the objects
+	Objects []string `json:"objects"`
+}
+
+// UsageNamespaces returns the namespaces of usage
+func UsageNamespaces(usage []NamespaceUsage) []string {
+	if len(usage) == 0 {
+		return nil
+	}
+
+	namespaces := make([]string, 0, len(usage))
+	for _, namespaceUsage := range usage {
+		namespaces = append(namespaces, namespaceUsage.Namespace)
+	}
+	return namespaces
 }
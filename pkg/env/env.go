@@ -10,10 +10,13 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/profile"
 	"github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -38,45 +41,60 @@ func Config() *viper.Viper {
 	return viperConfig
 }
 
-// InitConfig initializes application's configuration
-func InitConfig() (err error) {
+// InitConfig initializes application's configuration and returns the session to analyse
+func InitConfig() (*api.Session, error) {
 	// Fill in environment variables that match
 	viperConfig.SetEnvPrefix("PHRONETIC")
 	viperConfig.AutomaticEnv()
 
 	if err := setConfigLocation(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// If a config file is found, read it in.
 	readConfigErr := viperConfig.ReadInConfig()
 	// If no config file and save config file is undetermined, ask to create or save it for future use
-	if readConfigErr != nil && viperConfig.GetString("SaveConfig") != "false" {
+	if readConfigErr != nil && viperConfig.GetString("SaveConfig") != "false" && !NonInteractive() {
 		if err := surveySaveConfig(); err != nil {
-			return handleInterrupt(err)
+			return nil, handleInterrupt(err)
 		}
 		logrus.Debug("Can't read config file, all values were prompted and new config was asked to be created, err: ", readConfigErr)
 	}
 
 	// Parse kubeconfig for creating api client later
 	if err := api.ParseKubeConfig(); err != nil {
-		return errors.Wrap(err, "kubeconfig parsing failed")
+		return nil, errors.Wrap(err, "kubeconfig parsing failed")
 	}
 
-	// Ask for all values that are missing in ENV, flags or config yaml
-	if err := surveyMissingValues(); err != nil {
-		return handleInterrupt(err)
+	if NonInteractive() {
+		// All values must be provided by ENV, flags or config yaml
+		if err := validateValues(); err != nil {
+			return nil, err
+		}
+	} else {
+		// Ask for all values that are missing in ENV, flags or config yaml
+		if err := surveyMissingValues(); err != nil {
+			return nil, handleInterrupt(err)
+		}
+
+		// Values which can't be prompted are still validated
+		validationError := &ValidationError{}
+		validateRegistered(validationError)
+		if len(validationError.Problems) > 0 {
+			return nil, validationError
+		}
 	}
 
 	if viperConfig.GetString("SaveConfig") == "true" {
 		viperConfig.WriteConfig()
 	}
 
-	if err := createClients(); err != nil {
-		return handleInterrupt(err)
+	session, err := createSession()
+	if err != nil {
+		return nil, handleInterrupt(err)
 	}
 
-	return nil
+	return session, nil
 }
 
 // setConfigLocation sets location for phronetic configuration
@@ -137,8 +155,8 @@ func surveyMode() error {
 	mode := viperConfig.GetString("Mode")
 	if !viperConfig.InConfig("mode") && mode == "" {
 		prompt := &survey.Select{
-			Message: "Operational mode: Differential betweeen 2 clusters or Migration mode (CAM Operator)?",
-			Options: []string{"Migration", "Differential"},
+			Message: "Operational mode: Differential betweeen 2 clusters, Migration mode (CAM Operator) or Verification of a run migration?",
+			Options: Modes,
 		}
 		if err := survey.AskOne(prompt, &mode); err != nil {
 			return err
@@ -218,12 +236,18 @@ func surveyDiffMode() error {
 		return err
 	}
 
-	if err := surveySrcCluster(); err != nil {
-		return err
+	// A snapshot file can be used in place of each cluster
+	if viperConfig.GetString("SourceSnapshot") == "" {
+		if err := surveySrcCluster(); err != nil {
+			return err
+		}
 	}
 
-	if err := surveyDstCluster(); err != nil {
-		return err
+	// Destination cluster is optional with a target version, a snapshot or a reference profile can be used in its place
+	if viperConfig.GetString("DestinationSnapshot") == "" && viperConfig.GetString("DestinationProfile") == "" && !noDestination() {
+		if err := surveyDstCluster(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -377,98 +401,170 @@ func handleInterrupt(err error) error {
 	}
 }
 
-func createClients() error {
-	if Config().GetString("Mode") == "Differential" {
-		return createDiffModeClients()
+// createSession creates the clients of the clusters to analyse
+func createSession() (*api.Session, error) {
+	session := &api.Session{Mode: viperConfig.GetString("Mode")}
+
+	validationError := &ValidationError{}
+	session.NamespaceScope = namespaceScope(validationError)
+	if len(validationError.Problems) > 0 {
+		return nil, validationError
+	}
+
+	var err error
+	if session.Mode == "Differential" {
+		err = createDiffModeClients(session)
 	} else {
-		return createMigModeClients()
+		err = createMigModeClients(session)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
 }
 
-func createDiffModeClients() error {
-	srcClusterName := viperConfig.GetString("SourceCluster")
-	// set current context to selected cluster
-	api.KubeConfig.CurrentContext = api.ClusterNames[srcClusterName]
-	if err := api.CreateK8sSrcClient(srcClusterName); err != nil {
-		return errors.Wrap(err, "Source Cluster: k8s api client failed to create")
+func createDiffModeClients(session *api.Session) (err error) {
+	if session.Source, err = diffModeCluster("SourceSnapshot", "SourceCluster"); err != nil {
+		return errors.Wrap(err, "Source Cluster")
 	}
 
-	dstClusterName := viperConfig.GetString("DestinationCluster")
-	// set current context to selected cluster
-	api.KubeConfig.CurrentContext = api.ClusterNames[dstClusterName]
-	if err := api.CreateK8sDstClient(dstClusterName); err != nil {
-		return errors.Wrap(err, "Destination Cluster: k8s api client failed to create")
+	if noDestination() {
+		return nil
+	}
+
+	if name := viperConfig.GetString("DestinationProfile"); name != "" {
+		if session.Destination, err = profile.NewCluster(name); err != nil {
+			return errors.Wrap(err, "Destination Cluster")
+		}
+		return nil
+	}
+
+	if session.Destination, err = diffModeCluster("DestinationSnapshot", "DestinationCluster"); err != nil {
+		return errors.Wrap(err, "Destination Cluster")
 	}
 
 	return nil
 }
 
-func createMigModeClients() error {
-	migClusterName := viperConfig.GetString("MigrationCluster")
-	// set current context to selected cluster
-	api.KubeConfig.CurrentContext = api.ClusterNames[migClusterName]
-	if err := api.CreateCtrlClient(migClusterName); err != nil {
-		return errors.Wrap(err, "k8s controller client failed to create")
+// diffModeCluster returns the cluster loaded from the snapshot file when provided, otherwise from KUBECONFIG
+func diffModeCluster(snapshotKey, clusterKey string) (*api.Cluster, error) {
+	if snapshotFile := viperConfig.GetString(snapshotKey); snapshotFile != "" {
+		snapshot, err := loadSnapshot(snapshotFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "snapshot failed to load")
+		}
+		return api.NewSnapshotCluster(snapshot), nil
 	}
 
-	migPlan, err := api.GetMigPlan(api.CtrlClient, viperConfig.GetString("MigPlan"))
+	cluster, err := api.NewCluster(api.KubeConfig, api.ClusterNames[viperConfig.GetString(clusterKey)])
 	if err != nil {
-		logrus.Fatal("No MigPlan avail.")
+		return nil, errors.Wrap(err, "k8s api client failed to create")
 	}
-	api.MigPlan = &migPlan
+	return cluster, nil
+}
 
-	dstCluster := migPlan.Spec.DestMigClusterRef.Name
-	srcCluster := migPlan.Spec.SrcMigClusterRef.Name
+// loadSnapshot reads a snapshot file from its path, WorkDir isn't prepended:
+// "phronetic snapshot" logs the path of the file it writes into WorkDir
+func loadSnapshot(file string) (*api.Snapshot, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return api.LoadSnapshot(content)
+}
 
-	srcMigCluster := api.GetMigCluster(api.CtrlClient, srcCluster)
-	dstMigCluster := api.GetMigCluster(api.CtrlClient, dstCluster)
+// InitSnapshotConfig initializes configuration and returns the cluster to capture a snapshot from
+func InitSnapshotConfig() (*api.Cluster, error) {
+	viperConfig.SetEnvPrefix("PHRONETIC")
+	viperConfig.AutomaticEnv()
 
-	if srcMigCluster.Spec.IsHostCluster {
-		if err := api.CreateK8sSrcClient(migClusterName); err != nil {
-			return errors.Wrap(err, "Source Cluster: k8s api client failed to create")
-		}
-	} else {
-		noScheme := strings.Trim(srcMigCluster.Spec.URL, "https://")
-		srcClusterEndpoint := strings.ReplaceAll(noScheme, ".", "-")
-		srcContext, err := getContext(srcClusterEndpoint)
-		if err != nil {
-			logrus.Fatal(err)
-		}
+	if err := setConfigLocation(); err != nil {
+		return nil, err
+	}
 
-		// set current context to selected cluster
-		api.KubeConfig.CurrentContext = srcContext
-		if err := api.CreateK8sSrcClient(srcContext); err != nil {
-			return errors.Wrap(err, "Source Cluster: k8s api client failed to create")
-		}
+	if err := viperConfig.ReadInConfig(); err != nil {
+		logrus.Debug("Can't read config file, err: ", err)
+	}
 
-		if err := api.CreateK8sSrcDynClient(srcContext); err != nil {
-			return errors.Wrap(err, "Source Cluster: k8s api Dynamic client failed to create")
-		}
+	if err := api.ParseKubeConfig(); err != nil {
+		return nil, errors.Wrap(err, "kubeconfig parsing failed")
 	}
 
-	if dstMigCluster.Spec.IsHostCluster {
-		// set current context to selected cluster
-		dstContext := api.ClusterNames[migClusterName]
-		api.KubeConfig.CurrentContext = dstContext
-		if err := api.CreateK8sDstClient(migClusterName); err != nil {
-			return errors.Wrap(err, "Destination Cluster: k8s api client failed to create")
+	if NonInteractive() {
+		validationError := &ValidationError{}
+		validateCluster(validationError, "SnapshotCluster", "--cluster")
+		if len(validationError.Problems) > 0 {
+			return nil, validationError
 		}
-	} else {
-		noScheme := strings.Trim(dstMigCluster.Spec.URL, "https://")
-		dstClusterEndpoint := strings.ReplaceAll(noScheme, ".", "-")
-		dstContext, err := getContext(dstClusterEndpoint)
+	}
+
+	if viperConfig.GetString("SnapshotCluster") == "" {
+		clusterName, err := findCluster()
 		if err != nil {
-			logrus.Fatal(err)
-		}
-		// set current context to selected cluster
-		api.KubeConfig.CurrentContext = dstContext
-		if err := api.CreateK8sDstClient(dstContext); err != nil {
-			return errors.Wrap(err, "Destination Cluster: k8s api client failed to create")
+			return nil, handleInterrupt(err)
 		}
+		viperConfig.Set("SnapshotCluster", clusterName)
+	}
+
+	if viperConfig.GetString("WorkDir") == "" {
+		viperConfig.Set("WorkDir", ".")
+	}
+
+	cluster, err := api.NewCluster(api.KubeConfig, api.ClusterNames[viperConfig.GetString("SnapshotCluster")])
+	if err != nil {
+		return nil, errors.Wrap(err, "k8s api client failed to create")
+	}
+
+	return cluster, nil
+}
+
+func createMigModeClients(session *api.Session) (err error) {
+	migContext := api.ClusterNames[viperConfig.GetString("MigrationCluster")]
+	if session.CtrlClient, err = api.NewMigrationCtrlClient(api.KubeConfig, migContext); err != nil {
+		return errors.Wrap(err, "k8s controller client failed to create")
+	}
+
+	migPlan, err := api.GetMigPlan(session.CtrlClient, viperConfig.GetString("MigPlan"))
+	if err != nil {
+		return errors.Wrapf(err, "MigPlan %s not available", viperConfig.GetString("MigPlan"))
 	}
+	session.MigPlan = &migPlan
+
+	if session.Source, err = migModeCluster(session.CtrlClient, migPlan.Spec.SrcMigClusterRef.Name, migContext); err != nil {
+		return errors.Wrap(err, "Source Cluster")
+	}
+
+	if session.Destination, err = migModeCluster(session.CtrlClient, migPlan.Spec.DestMigClusterRef.Name, migContext); err != nil {
+		return errors.Wrap(err, "Destination Cluster")
+	}
+
 	return nil
 }
 
+// migModeCluster creates the cluster of a MigCluster, the host cluster being the migration cluster
+func migModeCluster(ctrlClient client.Client, migClusterName, migContext string) (*api.Cluster, error) {
+	migCluster, err := api.GetMigCluster(ctrlClient, migClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	context := migContext
+	if !migCluster.Spec.IsHostCluster {
+		noScheme := strings.Trim(migCluster.Spec.URL, "https://")
+		clusterEndpoint := strings.ReplaceAll(noScheme, ".", "-")
+		if context, err = getContext(clusterEndpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	cluster, err := api.NewCluster(api.KubeConfig, context)
+	if err != nil {
+		return nil, errors.Wrap(err, "k8s api client failed to create")
+	}
+	return cluster, nil
+}
+
 func getContext(clusterEndpoint string) (string, error) {
 	context := api.ClusterNames[clusterEndpoint]
 	if context == "" {
@@ -5,11 +5,9 @@ import (
 	"testing"
 	"time"
 
-	"github.com/gildub/phronetic/pkg/api"
 	"github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
-	"k8s.io/client-go/kubernetes"
 )
 
 func TestInitConfig(t *testing.T) {
@@ -23,8 +21,7 @@ func TestInitConfig(t *testing.T) {
 	os.Setenv("PHRONETIC_TARGETCLUSTERNAME", "")
 
 	ConfigFile = "testdata/cpma-config.yml"
-	api.K8sSrcClient = &kubernetes.Clientset{}
-	if err := InitConfig(); err != nil {
+	if _, err := InitConfig(); err != nil {
 		t.Fatal(err)
 	}
 
@@ -129,13 +126,12 @@ func TestInitFromEnv(t *testing.T) {
 			os.Setenv("PHRONETIC_NODECONFIGFILE", "/etc/origin/node/node-config.yaml")
 			os.Setenv("PHRONETIC_REGISTRIESCONFIGFILE", "/etc/containers/registries.conf")
 
-			api.K8sSrcClient = &kubernetes.Clientset{}
 			for _, asset := range tc.sourceConfig {
 				err = os.Setenv(asset.envKey, asset.envValue)
 				assert.NoError(t, err, "Unable to export %s=%s", asset.envKey, asset.envValue)
 			}
 
-			err = InitConfig()
+			_, err = InitConfig()
 			assert.NoError(t, err, "Unable to initialize config")
 			for _, asset := range tc.sourceConfig {
 				assert.Equal(t, asset.envValue, viperConfig.GetString(asset.configEquivalent))
@@ -0,0 +1,187 @@
+package env
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/profile"
+	"github.com/gildub/phronetic/pkg/transform/deprecation"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ValidationExitCode is the exit code used when configuration is invalid in non-interactive mode
+const ValidationExitCode = 2
+
+// Modes lists available operational modes
+var Modes = []string{"Migration", "Differential", "Verification"}
+
+// ValidationError aggregates all missing or invalid configuration values
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid configuration:\n - %s", strings.Join(e.Problems, "\n - "))
+}
+
+func (e *ValidationError) add(format string, args ...interface{}) {
+	e.Problems = append(e.Problems, fmt.Sprintf(format, args...))
+}
+
+// Validator checks configuration values owned by a package env can't import, it returns the problems found
+type Validator func(config *viper.Viper) []string
+
+var validators []Validator
+
+// AddValidator registers a Validator run along with configuration validation
+func AddValidator(validator Validator) {
+	validators = append(validators, validator)
+}
+
+// validateRegistered runs registered validators
+func validateRegistered(validationError *ValidationError) {
+	for _, validator := range validators {
+		for _, problem := range validator(viperConfig) {
+			validationError.add("%s", problem)
+		}
+	}
+}
+
+// IsValidationError returns true when err was caused by invalid configuration
+func IsValidationError(err error) bool {
+	_, ok := errors.Cause(err).(*ValidationError)
+	return ok
+}
+
+// NonInteractive returns true when values must not be prompted
+func NonInteractive() bool {
+	return viperConfig.GetBool("NonInteractive")
+}
+
+// validateValues checks all required values are provided, instead of prompting them
+func validateValues() error {
+	validationError := &ValidationError{}
+
+	switch mode := viperConfig.GetString("Mode"); mode {
+	case "":
+		validationError.add("Mode is missing, set --mode or PHRONETIC_MODE to one of: %s", strings.Join(Modes, ", "))
+	case "Differential":
+		if viperConfig.GetString("SourceSnapshot") == "" {
+			validateCluster(validationError, "SourceCluster", "--source-cluster or --source-snapshot")
+		}
+		if viperConfig.GetString("DestinationSnapshot") == "" && viperConfig.GetString("DestinationProfile") == "" && !noDestination() {
+			validateCluster(validationError, "DestinationCluster", "--destination-cluster, --destination-snapshot or --destination-profile")
+		}
+	case "Migration", "Verification":
+		validateCluster(validationError, "MigrationCluster", "--migration-cluster")
+		if viperConfig.GetString("MigPlan") == "" {
+			validationError.add("MigPlan is missing, set --migplan or PHRONETIC_MIGPLAN")
+		}
+	default:
+		validationError.add("Mode %q is invalid, available modes: %s", mode, strings.Join(Modes, ", "))
+	}
+
+	namespaceScope(validationError)
+	validateDestinationProfile(validationError)
+
+	if targetVersion := viperConfig.GetString("TargetVersion"); targetVersion != "" {
+		if _, err := deprecation.ParseVersion(targetVersion); err != nil {
+			validationError.add("TargetVersion is invalid: %s", err)
+		}
+	}
+
+	if viperConfig.GetString("WorkDir") == "" {
+		validationError.add("WorkDir is missing, set --work-dir or PHRONETIC_WORKDIR")
+	}
+
+	validateRegistered(validationError)
+
+	if len(validationError.Problems) > 0 {
+		return validationError
+	}
+	return nil
+}
+
+// noDestination returns true when Differential mode runs without destination cluster, snapshot nor profile,
+// only APIs deprecated by the target version are then looked for
+func noDestination() bool {
+	return viperConfig.GetString("DestinationSnapshot") == "" && viperConfig.GetString("DestinationCluster") == "" &&
+		viperConfig.GetString("DestinationProfile") == "" && viperConfig.GetString("TargetVersion") != ""
+}
+
+// validateDestinationProfile checks the reference profile standing for destination cluster is known and used alone
+func validateDestinationProfile(validationError *ValidationError) {
+	name := viperConfig.GetString("DestinationProfile")
+	if name == "" {
+		return
+	}
+
+	if mode := viperConfig.GetString("Mode"); mode != "Differential" {
+		validationError.add("DestinationProfile can only be used in Differential mode, %s mode uses MigPlan clusters", mode)
+	}
+
+	if viperConfig.GetString("DestinationCluster") != "" || viperConfig.GetString("DestinationSnapshot") != "" {
+		validationError.add("DestinationProfile replaces destination cluster, set only one of --destination-cluster, --destination-snapshot or --destination-profile")
+	}
+
+	names := profile.Names()
+	for _, known := range names {
+		if known == name {
+			return
+		}
+	}
+	validationError.add("DestinationProfile %q is unknown, available profiles: %s", name, strings.Join(names, ", "))
+}
+
+// validateCluster checks cluster configuration key is set to a cluster from KUBECONFIG
+func validateCluster(validationError *ValidationError, key, flags string) {
+	clusterName := viperConfig.GetString(key)
+	if clusterName == "" {
+		validationError.add("%s is missing, set %s or PHRONETIC_%s", key, flags, strings.ToUpper(key))
+		return
+	}
+
+	if _, ok := api.ClusterNames[clusterName]; !ok {
+		validationError.add("%s %q is not a cluster of KUBECONFIG", key, clusterName)
+	}
+}
+
+// namespaceScope returns the source namespaces selected to look for objects in Differential mode,
+// problems are added to validationError
+func namespaceScope(validationError *ValidationError) api.NamespaceScope {
+	scope := api.NamespaceScope{
+		Names:    viperConfig.GetStringSlice("Namespaces"),
+		Selector: viperConfig.GetString("NamespaceSelector"),
+		All:      viperConfig.GetBool("AllNamespaces"),
+	}
+	if scope.IsEmpty() {
+		return scope
+	}
+
+	selected := 0
+	for _, set := range []bool{len(scope.Names) > 0, scope.Selector != "", scope.All} {
+		if set {
+			selected++
+		}
+	}
+	if selected > 1 {
+		validationError.add("Namespaces are selected more than once, set only one of --namespaces, --namespace-selector or --all-namespaces")
+	}
+
+	if scope.Selector != "" {
+		if _, err := labels.Parse(scope.Selector); err != nil {
+			validationError.add("NamespaceSelector %q is invalid: %s", scope.Selector, err)
+		}
+	}
+
+	if mode := viperConfig.GetString("Mode"); mode != "Differential" {
+		validationError.add("Namespaces can only be selected in Differential mode, %s mode uses MigPlan namespaces", mode)
+	} else if viperConfig.GetString("SourceSnapshot") != "" {
+		validationError.add("Namespaces can't be selected with --source-snapshot, objects are only listed from a live source cluster")
+	}
+	return scope
+}
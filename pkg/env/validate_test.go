@@ -0,0 +1,211 @@
+package env
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateValues(t *testing.T) {
+	keys := []string{"Mode", "MigrationCluster", "MigPlan", "SourceCluster", "DestinationCluster", "SourceSnapshot", "DestinationSnapshot", "DestinationProfile", "TargetVersion", "WorkDir"}
+	defer func() {
+		for _, key := range keys {
+			viperConfig.Set(key, "")
+		}
+	}()
+	api.ClusterNames = map[string]string{"cluster1": "context1", "cluster2": "context2"}
+
+	testCases := []struct {
+		name     string
+		values   map[string]string
+		expected []string
+	}{
+		{
+			name: "all values missing",
+			expected: []string{
+				"Mode is missing, set --mode or PHRONETIC_MODE to one of: Migration, Differential, Verification",
+				"WorkDir is missing, set --work-dir or PHRONETIC_WORKDIR",
+			},
+		},
+		{
+			name:   "invalid mode",
+			values: map[string]string{"Mode": "Other", "WorkDir": "."},
+			expected: []string{
+				`Mode "Other" is invalid, available modes: Migration, Differential, Verification`,
+			},
+		},
+		{
+			name:   "migration mode values missing",
+			values: map[string]string{"Mode": "Migration"},
+			expected: []string{
+				"MigrationCluster is missing, set --migration-cluster or PHRONETIC_MIGRATIONCLUSTER",
+				"MigPlan is missing, set --migplan or PHRONETIC_MIGPLAN",
+				"WorkDir is missing, set --work-dir or PHRONETIC_WORKDIR",
+			},
+		},
+		{
+			name:   "differential mode unknown cluster",
+			values: map[string]string{"Mode": "Differential", "SourceCluster": "cluster3", "WorkDir": "."},
+			expected: []string{
+				`SourceCluster "cluster3" is not a cluster of KUBECONFIG`,
+				"DestinationCluster is missing, set --destination-cluster, --destination-snapshot or --destination-profile or PHRONETIC_DESTINATIONCLUSTER",
+			},
+		},
+		{
+			name:   "differential mode with snapshots",
+			values: map[string]string{"Mode": "Differential", "SourceSnapshot": "src.json", "DestinationCluster": "cluster2", "WorkDir": "."},
+		},
+		{
+			name:   "differential mode with destination snapshot",
+			values: map[string]string{"Mode": "Differential", "SourceCluster": "cluster1", "DestinationSnapshot": "dst.json", "WorkDir": "."},
+		},
+		{
+			name:   "differential mode with destination profile",
+			values: map[string]string{"Mode": "Differential", "SourceCluster": "cluster1", "DestinationProfile": "openshift-4.6", "WorkDir": "."},
+		},
+		{
+			name:   "destination profile with destination cluster",
+			values: map[string]string{"Mode": "Differential", "SourceCluster": "cluster1", "DestinationCluster": "cluster2", "DestinationProfile": "openshift-4.6", "WorkDir": "."},
+			expected: []string{
+				"DestinationProfile replaces destination cluster, set only one of --destination-cluster, --destination-snapshot or --destination-profile",
+			},
+		},
+		{
+			name:   "unknown destination profile in migration mode",
+			values: map[string]string{"Mode": "Migration", "MigrationCluster": "cluster1", "MigPlan": "plan", "DestinationProfile": "openshift-3.0", "WorkDir": "."},
+			expected: []string{
+				"DestinationProfile can only be used in Differential mode, Migration mode uses MigPlan clusters",
+				`DestinationProfile "openshift-3.0" is unknown, available profiles: kubernetes-1.19, kubernetes-1.22, openshift-4.6, openshift-4.9`,
+			},
+		},
+		{
+			name:   "differential mode with target version",
+			values: map[string]string{"Mode": "Differential", "SourceCluster": "cluster1", "TargetVersion": "4.6", "WorkDir": "."},
+		},
+		{
+			name:   "invalid target version",
+			values: map[string]string{"Mode": "Differential", "SourceCluster": "cluster1", "TargetVersion": "2.1", "WorkDir": "."},
+			expected: []string{
+				`TargetVersion is invalid: version "2.1" is neither a Kubernetes 1.x, OpenShift 3.6+ nor OpenShift 4.x version`,
+			},
+		},
+		{
+			name:   "verification mode",
+			values: map[string]string{"Mode": "Verification", "MigrationCluster": "cluster1", "MigPlan": "plan", "WorkDir": "."},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, key := range keys {
+				viperConfig.Set(key, tc.values[key])
+			}
+
+			err := validateValues()
+			if tc.expected == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, IsValidationError(err))
+			assert.Equal(t, tc.expected, err.(*ValidationError).Problems)
+		})
+	}
+}
+
+func TestNamespaceScope(t *testing.T) {
+	keys := []string{"Mode", "SourceSnapshot", "Namespaces", "NamespaceSelector", "AllNamespaces"}
+	defer func() {
+		for _, key := range keys {
+			viperConfig.Set(key, nil)
+		}
+	}()
+
+	testCases := []struct {
+		name     string
+		values   map[string]interface{}
+		scope    api.NamespaceScope
+		expected []string
+	}{
+		{
+			name:   "no namespaces",
+			values: map[string]interface{}{"Mode": "Migration"},
+		},
+		{
+			name:   "namespace names",
+			values: map[string]interface{}{"Mode": "Differential", "Namespaces": []string{"app1", "app2"}},
+			scope:  api.NamespaceScope{Names: []string{"app1", "app2"}},
+		},
+		{
+			name:   "all namespaces",
+			values: map[string]interface{}{"Mode": "Differential", "AllNamespaces": true},
+			scope:  api.NamespaceScope{All: true},
+		},
+		{
+			name:   "invalid selector selected twice",
+			values: map[string]interface{}{"Mode": "Differential", "NamespaceSelector": "team in (a", "AllNamespaces": true},
+			scope:  api.NamespaceScope{Selector: "team in (a", All: true},
+			expected: []string{
+				"Namespaces are selected more than once, set only one of --namespaces, --namespace-selector or --all-namespaces",
+				`NamespaceSelector "team in (a" is invalid: unable to parse requirement: found '', expected: ',' or ')'`,
+			},
+		},
+		{
+			name:     "migration mode",
+			values:   map[string]interface{}{"Mode": "Migration", "NamespaceSelector": "team=a"},
+			scope:    api.NamespaceScope{Selector: "team=a"},
+			expected: []string{"Namespaces can only be selected in Differential mode, Migration mode uses MigPlan namespaces"},
+		},
+		{
+			name:     "source snapshot",
+			values:   map[string]interface{}{"Mode": "Differential", "SourceSnapshot": "src.json", "AllNamespaces": true},
+			scope:    api.NamespaceScope{All: true},
+			expected: []string{"Namespaces can't be selected with --source-snapshot, objects are only listed from a live source cluster"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, key := range keys {
+				viperConfig.Set(key, tc.values[key])
+			}
+
+			validationError := &ValidationError{}
+			scope := namespaceScope(validationError)
+			assert.Equal(t, tc.expected, validationError.Problems)
+			if tc.scope.IsEmpty() {
+				assert.True(t, scope.IsEmpty())
+				return
+			}
+			assert.Equal(t, tc.scope, scope)
+		})
+	}
+}
+
+func TestAddValidator(t *testing.T) {
+	registered := validators
+	defer func() { validators = registered }()
+
+	AddValidator(func(config *viper.Viper) []string {
+		if format := config.GetString("Output"); format != "json" {
+			return []string{fmt.Sprintf("Unknown report format %q", format)}
+		}
+		return nil
+	})
+	defer viperConfig.Set("Output", nil)
+
+	viperConfig.Set("Output", "pdf")
+	validationError := &ValidationError{}
+	validateRegistered(validationError)
+	assert.Equal(t, []string{`Unknown report format "pdf"`}, validationError.Problems)
+
+	viperConfig.Set("Output", "json")
+	validationError = &ValidationError{}
+	validateRegistered(validationError)
+	assert.Empty(t, validationError.Problems)
+}
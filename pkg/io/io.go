@@ -9,15 +9,19 @@ import (
 	"github.com/gildub/phronetic/pkg/env"
 )
 
+// FilePath returns the path of a file in WorkDir
+func FilePath(file string) string {
+	return filepath.Join(env.Config().GetString("WorkDir"), file)
+}
+
 // ReadFile reads a file from WorkDir and returns its contents
 func ReadFile(file string) ([]byte, error) {
-	src := filepath.Join(env.Config().GetString("WorkDir"), file)
-	return ioutil.ReadFile(src)
+	return ioutil.ReadFile(FilePath(file))
 }
 
 // WriteFile writes data to a file into WorkDir
 func WriteFile(content []byte, file string) error {
-	dst := filepath.Join(env.Config().GetString("WorkDir"), file)
+	dst := FilePath(file)
 	os.MkdirAll(path.Dir(dst), 0750)
 	return ioutil.WriteFile(dst, content, 0640)
 }
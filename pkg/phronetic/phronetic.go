@@ -0,0 +1,174 @@
+// Package phronetic runs analyses from Go programs.
+// Unlike the command line it never prompts, nor reads configuration files, environment variables or KUBECONFIG,
+// and reports are returned instead of being written to disk.
+package phronetic
+
+import (
+	"context"
+	"time"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/profile"
+	"github.com/gildub/phronetic/pkg/transform"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/gildub/phronetic/pkg/transform/verification"
+	"github.com/pkg/errors"
+
+	"k8s.io/client-go/rest"
+)
+
+// ClusterConfig gives access to a cluster, either a live one with its REST config or an offline one with its snapshot
+type ClusterConfig struct {
+	// Name is the cluster name used in reports, defaults to the snapshot cluster name or to the REST config host
+	Name string
+	// RESTConfig is used to access a live cluster
+	RESTConfig *rest.Config
+	// Snapshot replaces a live cluster in Differential mode
+	Snapshot *api.Snapshot
+	// Profile is the name of a reference profile replacing a cluster which doesn't exist yet in Differential mode,
+	// see profile.Names
+	Profile string
+}
+
+// Options configures an analysis
+type Options struct {
+	// Mode is the operational mode: Migration, Differential or Verification
+	Mode   string
+	Source ClusterConfig
+	// Destination is optional in Differential mode when TargetVersion is set, only deprecated APIs are then looked for
+	Destination ClusterConfig
+	// MigrationCluster is the REST config of the cluster running the migration controller,
+	// required in Migration and Verification modes
+	MigrationCluster *rest.Config
+	// MigPlan is the name of the migration plan to analyse in Migration and Verification modes
+	MigPlan string
+	// NamespaceScope selects source namespaces to look for objects of resources which can't be migrated,
+	// only in Differential mode with a live source cluster
+	NamespaceScope api.NamespaceScope
+	// TargetVersion is the Kubernetes or OpenShift release deprecated APIs are looked for, defaults to destination cluster version
+	TargetVersion string
+	// Rules are custom normalization rules used in Verification mode
+	Rules verification.Rules
+	// Workers is the maximum number of transforms run concurrently, defaults to transform.DefaultWorkers
+	Workers int
+	// Enable lists checks to run in addition to default ones
+	Enable []string
+	// Disable lists default checks not to run
+	Disable []string
+	// TransformTimeout bounds the run of each transform, there is no timeout when zero
+	TransformTimeout time.Duration
+}
+
+// Analyze runs an analysis and returns its report.
+// When some transform fails, its error is returned along with the report generated by the others.
+func Analyze(ctx context.Context, options Options) (reportoutput.ReportOutput, error) {
+	checks, err := transform.SelectChecks(options.Mode, options.Enable, options.Disable)
+	if err != nil {
+		return reportoutput.ReportOutput{}, err
+	}
+
+	session, err := NewSession(options)
+	if err != nil {
+		return reportoutput.ReportOutput{}, err
+	}
+
+	config := transform.CheckConfig{Session: session, Rules: options.Rules, TargetVersion: options.TargetVersion}
+	return transform.NewRunner(options.Workers, options.TransformTimeout).Analyze(ctx, config, checks)
+}
+
+// NewSession creates the clusters and retrieves the migration plan of an analysis
+func NewSession(options Options) (*api.Session, error) {
+	session := &api.Session{Mode: options.Mode, NamespaceScope: options.NamespaceScope}
+
+	switch options.Mode {
+	case "Differential":
+		if !options.NamespaceScope.IsEmpty() && options.Source.Snapshot != nil {
+			return nil, errors.New("Namespace scope requires a live source cluster")
+		}
+	case "Migration", "Verification":
+		if options.MigrationCluster == nil {
+			return nil, errors.Errorf("Migration cluster is required in %s mode", options.Mode)
+		}
+		if options.MigPlan == "" {
+			return nil, errors.Errorf("MigPlan is required in %s mode", options.Mode)
+		}
+		if !options.NamespaceScope.IsEmpty() {
+			return nil, errors.Errorf("Namespace scope can't be used in %s mode, MigPlan namespaces are analysed", options.Mode)
+		}
+	default:
+		return nil, errors.Errorf("Invalid mode %q, must be one of Migration, Differential, Verification", options.Mode)
+	}
+
+	var err error
+	if session.Source, err = newCluster(options.Source, options.Mode); err != nil {
+		return nil, errors.Wrap(err, "Source Cluster")
+	}
+
+	if options.Mode == "Differential" && options.TargetVersion != "" && options.Destination == (ClusterConfig{}) {
+		return session, nil
+	}
+
+	if session.Destination, err = newCluster(options.Destination, options.Mode); err != nil {
+		return nil, errors.Wrap(err, "Destination Cluster")
+	}
+
+	if options.Mode == "Differential" {
+		return session, nil
+	}
+
+	if session.CtrlClient, err = api.NewMigrationCtrlClientFromConfig(options.MigrationCluster); err != nil {
+		return nil, errors.Wrap(err, "k8s controller client failed to create")
+	}
+
+	migPlan, err := api.GetMigPlan(session.CtrlClient, options.MigPlan)
+	if err != nil {
+		return nil, errors.Wrapf(err, "MigPlan %s not available", options.MigPlan)
+	}
+	session.MigPlan = &migPlan
+
+	return session, nil
+}
+
+func newCluster(config ClusterConfig, mode string) (*api.Cluster, error) {
+	if config.Snapshot != nil {
+		if mode != "Differential" {
+			return nil, errors.Errorf("snapshot can't replace a live cluster in %s mode", mode)
+		}
+
+		cluster := api.NewSnapshotCluster(config.Snapshot)
+		if config.Name != "" {
+			cluster.Name = config.Name
+		}
+		return cluster, nil
+	}
+
+	if config.Profile != "" {
+		if mode != "Differential" {
+			return nil, errors.Errorf("profile can't replace a live cluster in %s mode", mode)
+		}
+
+		cluster, err := profile.NewCluster(config.Profile)
+		if err != nil {
+			return nil, err
+		}
+		if config.Name != "" {
+			cluster.Name = config.Name
+		}
+		return cluster, nil
+	}
+
+	if config.RESTConfig == nil {
+		return nil, errors.New("REST config, snapshot or profile is required")
+	}
+
+	name := config.Name
+	if name == "" {
+		name = config.RESTConfig.Host
+	}
+
+	cluster, err := api.NewClusterFromConfig(name, config.RESTConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "k8s api client failed to create")
+	}
+	return cluster, nil
+}
@@ -0,0 +1,190 @@
+package phronetic
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/cluster"
+	"github.com/gildub/phronetic/pkg/transform/deprecation"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestAnalyzeDifferential(t *testing.T) {
+	options := Options{
+		Mode:        "Differential",
+		Source:      ClusterConfig{Snapshot: loadSnapshot(t, "../transform/testdata/snapshot-src.json")},
+		Destination: ClusterConfig{Name: "destination", Snapshot: loadSnapshot(t, "../transform/testdata/snapshot-dst.json")},
+	}
+
+	report, err := Analyze(context.Background(), options)
+	require.NoError(t, err)
+
+	assert.Equal(t, reportoutput.StatusComplete, report.Status)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, []reportoutput.SkippedTransform{
+		{Transform: "CRD", Reason: "it requires a live source cluster"},
+	}, report.Skipped)
+
+	require.Len(t, report.Sections, 2)
+	diffReport, ok := report.Section(cluster.DiffSectionName).(cluster.ReportDiff)
+	require.True(t, ok)
+	assert.Equal(t, "source-example-com", diffReport.ReportSrcCluster.ClusterName)
+	assert.Equal(t, "destination", diffReport.ReportDstCluster.ClusterName)
+	assert.Contains(t, diffReport.ReportSrcCluster.GapGVKs, "cronjobs")
+
+	deprecationReport, ok := report.Section(deprecation.SectionName).(deprecation.ReportDeprecation)
+	require.True(t, ok)
+	assert.Equal(t, "1.16", deprecationReport.TargetVersion.String())
+}
+
+func TestAnalyzeTargetVersion(t *testing.T) {
+	options := Options{
+		Mode:          "Differential",
+		Source:        ClusterConfig{Snapshot: loadSnapshot(t, "../transform/testdata/snapshot-src.json")},
+		TargetVersion: "4.9",
+	}
+
+	report, err := Analyze(context.Background(), options)
+	require.NoError(t, err)
+
+	assert.Equal(t, reportoutput.StatusComplete, report.Status)
+	assert.Empty(t, report.Errors)
+	assert.Equal(t, []reportoutput.SkippedTransform{
+		{Transform: "Cluster", Reason: "it requires a destination cluster"},
+		{Transform: "CRD", Reason: "it requires a destination cluster"},
+	}, report.Skipped)
+
+	require.Len(t, report.Sections, 1)
+	deprecationReport, ok := report.Section(deprecation.SectionName).(deprecation.ReportDeprecation)
+	require.True(t, ok)
+	assert.Equal(t, "1.22", deprecationReport.TargetVersion.String())
+
+	removed := []string{}
+	for _, resource := range deprecationReport.Resources {
+		if resource.Removed {
+			removed = append(removed, resource.GVK.GroupVersion().String()+" "+resource.GVK.Kind)
+		}
+	}
+	assert.Equal(t, []string{"batch/v2alpha1 CronJob", "apps/v1beta1 Deployment", "extensions/v1beta1 Ingress"}, removed)
+}
+
+func TestAnalyzeDestinationProfile(t *testing.T) {
+	options := Options{
+		Mode:        "Differential",
+		Source:      ClusterConfig{Snapshot: loadSnapshot(t, "../transform/testdata/snapshot-src.json")},
+		Destination: ClusterConfig{Profile: "openshift-4.9"},
+	}
+
+	report, err := Analyze(context.Background(), options)
+	require.NoError(t, err)
+	assert.Equal(t, "openshift-4.9", report.Profile)
+
+	require.Len(t, report.Sections, 2)
+	diffReport, ok := report.Section(cluster.DiffSectionName).(cluster.ReportDiff)
+	require.True(t, ok)
+	assert.Equal(t, "openshift-4.9", diffReport.ReportDstCluster.ClusterName)
+	assert.Equal(t, "openshift-4.9", diffReport.ReportDstCluster.Profile)
+	assert.Empty(t, diffReport.ReportSrcCluster.Profile)
+
+	deprecationReport, ok := report.Section(deprecation.SectionName).(deprecation.ReportDeprecation)
+	require.True(t, ok)
+	assert.Equal(t, "1.22", deprecationReport.TargetVersion.String())
+	assert.Equal(t, "openshift-4.9", deprecationReport.TargetProfile)
+}
+
+func TestNewSession(t *testing.T) {
+	snapshot := loadSnapshot(t, "../transform/testdata/snapshot-src.json")
+
+	testCases := []struct {
+		name          string
+		options       Options
+		expectedError string
+	}{
+		{
+			name:          "invalid mode",
+			options:       Options{Mode: "Unknown"},
+			expectedError: `Invalid mode "Unknown", must be one of Migration, Differential, Verification`,
+		},
+		{
+			name:          "missing destination",
+			options:       Options{Mode: "Differential", Source: ClusterConfig{Snapshot: snapshot}},
+			expectedError: "Destination Cluster: REST config, snapshot or profile is required",
+		},
+		{
+			name:          "unknown destination profile",
+			options:       Options{Mode: "Differential", Source: ClusterConfig{Snapshot: snapshot}, Destination: ClusterConfig{Profile: "openshift-3.0"}},
+			expectedError: `Destination Cluster: Unknown profile "openshift-3.0", available profiles: kubernetes-1.19, kubernetes-1.22, openshift-4.6, openshift-4.9`,
+		},
+		{
+			name: "profile in Verification mode",
+			options: Options{
+				Mode:             "Verification",
+				MigPlan:          "plan",
+				MigrationCluster: &rest.Config{},
+				Source:           ClusterConfig{Profile: "openshift-4.6"},
+			},
+			expectedError: "Source Cluster: profile can't replace a live cluster in Verification mode",
+		},
+		{
+			name:          "missing migration cluster",
+			options:       Options{Mode: "Migration", MigPlan: "plan"},
+			expectedError: "Migration cluster is required in Migration mode",
+		},
+		{
+			name:          "missing MigPlan",
+			options:       Options{Mode: "Verification", MigrationCluster: &rest.Config{}},
+			expectedError: "MigPlan is required in Verification mode",
+		},
+		{
+			name: "snapshot in Migration mode",
+			options: Options{
+				Mode:             "Migration",
+				MigPlan:          "plan",
+				MigrationCluster: &rest.Config{},
+				Source:           ClusterConfig{Snapshot: snapshot},
+			},
+			expectedError: "Source Cluster: snapshot can't replace a live cluster in Migration mode",
+		},
+		{
+			name: "namespace scope in Verification mode",
+			options: Options{
+				Mode:             "Verification",
+				MigPlan:          "plan",
+				MigrationCluster: &rest.Config{},
+				NamespaceScope:   api.NamespaceScope{All: true},
+			},
+			expectedError: "Namespace scope can't be used in Verification mode, MigPlan namespaces are analysed",
+		},
+		{
+			name: "namespace scope with source snapshot",
+			options: Options{
+				Mode:           "Differential",
+				Source:         ClusterConfig{Snapshot: snapshot},
+				NamespaceScope: api.NamespaceScope{Names: []string{"app1"}},
+			},
+			expectedError: "Namespace scope requires a live source cluster",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewSession(tc.options)
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func loadSnapshot(t *testing.T, file string) *api.Snapshot {
+	content, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+
+	snapshot, err := api.LoadSnapshot(content)
+	require.NoError(t, err)
+	return snapshot
+}
@@ -0,0 +1,8 @@
+// +build dev
+
+package profile
+
+import "net/http"
+
+// Assets contains reference profiles
+var Assets http.FileSystem = http.Dir("resources")
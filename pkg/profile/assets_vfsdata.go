@@ -0,0 +1,210 @@
+// Code generated by vfsgen; DO NOT EDIT.
+
+// +build !dev
+
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	pathpkg "path"
+	"time"
+)
+
+// Assets statically implements the virtual filesystem provided to vfsgen.
+var Assets = func() http.FileSystem {
+	fs := vfsgen۰FS{
+		"/": &vfsgen۰DirInfo{
+			name:    "/",
+			modTime: time.Date(2026, 10, 17, 7, 15, 57, 331529535, time.UTC),
+		},
+		"/kubernetes-1.19.json": &vfsgen۰CompressedFileInfo{
+			name:             "kubernetes-1.19.json",
+			modTime:          time.Date(2026, 10, 17, 7, 15, 57, 324321492, time.UTC),
+			uncompressedSize: 29468,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\xcf\x6f\xdb\x3a\x12\xc7\xef\xfe\x2b\x04\x9d\xd3\x6c\x92\xc3\xa2\xcd\x2d\x75\x16\xd8\x2c\xda\x20\x6b\x07\x5d\x60\x1f\x7a\xa0\xa9\xb1\xc3\x86\x22\x55\x92\x72\x5e\x5e\x90\xff\xfd\x81\xfa\x65\xc7\xa6\x6c\x52\xa2\x52\xcb\x15\x50\x14\xb1\x45\x7e\x39\xf3\xd1\x70\x44\x93\x22\x5f\x46\x41\xb8\x04\x21\x09\x67\xe1\x65\x10\x2e\xcf\xc3\x93\x51\x10\x62\x9a\x4a\x05\xe2\x16\xc5\xa0\xbf\x7d\x4c\x67\x20\x18\x28\x90\x1f\xce\x4f\xcf\x3f\x65\x45\x24\x88\x25\x88\x6f\xeb\x55\x4f\xcf\x3f\x9d\x9e\x65\x17\xb1\x00\xa4\x08\x67\xf7\x24\x06\xa9\x50\x9c\xe8\x02\x17\x67\x17\x67\x1f\xce\x3e\x7e\xb8\xf8\xe7\xfd\xd9\xd9\x65\xf6\xef\xff\x59\xe9\x85\xe0\x69\x22\xc3\xcb\xe0\x8f\x51\x10\xbc\x8c\x82\x20\x08\x59\xd1\xb2\xbe\x1e\x54\x16\x96\x65\x8a\x52\x41\x51\xf5\x8d\x11\x79\x8d\x60\xd3\xab\xec\xcb\x57\xfd\xff\xf7\xac\x40\x98\x08\x98\x83\x10\x10\xad\x6a\xbf\x8c\x76\x6b\x1a\x24\xb5\xe2\xeb\xc9\x96\xd9\x28\x21\x02\x16\x44\x2a\x91\x61\x38\x7d\xfc\x28\x4f\x09\x77\x75\xc6\xac\xf2\x8f\x3d\x3e\x9e\x34\xd3\x9c\x81\x42\x35\xc2\xf9\xa5\x16\x04\xf7\x39\x62\x0f\x16\xfe\x54\xc0\x74\x51\xe9\x0a\x73\x55\xb3\x63\x67\x6b\x1b\xaa\x6b\xa7\x36\x86\x12\x67\x27\x51\x92\xc8\x7d\xf1\xd1\xf0\x0e\xae\x0b\x3b\xdc\xaf\x25\x30\x25\x8b\x1b\xee\x7c\xcb\xd6\x2b\xb7\x0b\xfb\x4d\xa9\x4e\x03\xc0\x6c\xb6\x3d\x34\x94\xaa\x07\x60\x8a\xe0\xf5\xde\xe2\x1c\x0a\x26\x91\x76\x10\x8d\x76\x75\x0c\x73\x8f\x1b\x6e\x50\xb9\x20\x7f\xb5\x65\xba\xa9\xd1\x1e\xa9\x41\xb1\x6b\xa2\xf5\x4e\x38\x01\xe5\x12\x23\x4a\xd8\xa2\x01\xc7\xb2\x6a\x6b\x7c\x2b\xa1\x8b\x7a\x6a\xc5\xa5\x66\x92\x17\xf5\x92\x17\x2d\x6f\xc4\x36\x05\x7b\xfe\x33\xa4\xf0\x83\x2b\xf9\xac\x52\x3b\xe6\xa5\x44\x97\x31\xba\x61\xa6\x3d\x14\x0c\x42\x91\xb9\x4e\x7a\x50\x66\x60\x57\x44\x06\x89\x76\xc0\xcc\x82\x5d\xe2\xdb\xe9\x82\x3d\x4c\x06\xea\x89\x8b\x47\xc2\x16\x0d\x51\x6e\x09\xb4\x03\x69\x92\xeb\x12\xe3\x0e\xf3\xed\x21\x26\x9c\x12\xfc\xec\x4a\x2e\xaf\xd5\xb1\x7f\xc6\x46\xea\xda\xa8\x71\x4f\xcc\x10\x3e\x35\x3d\x52\x5c\x3d\xae\x15\x6a\x17\x33\xbb\x64\xbb\x64\x6b\xe1\x8e\x7d\x0c\x49\xc5\x05\x5a\x40\x43\xb2\x6f\x6b\xb7\xc3\xb9\xa5\xd5\x25\xc3\x3a\xc3\xed\xc1\xa1\x28\x26\x52\x17\x35\xfc\xfc\x74\xc5\xb8\x4b\xab\x1d\xd4\x3d\xca\x5d\x22\xb6\x73\xca\x01\x78\x42\x56\xbf\x7f\x9b\x82\x36\x68\xb4\x04\x6c\x56\xec\x14\xec\x4e\x27\xec\x81\x4a\xfc\x00\x51\x4a\x57\xcf\x20\x57\x9a\x5b\x02\xed\x50\x9a\xe4\xba\xe4\xb8\xc3\x7c\x7b\x88\x98\x73\x11\x11\xb6\x1e\xdc\xae\x18\x0d\x12\xed\x40\x9a\x05\xbb\x44\xb9\xd3\x05\x7b\x98\x8c\x47\xd0\x10\xe2\x5a\xd5\x8e\x7d\xad\x6f\xa9\xae\xa1\x1a\x6f\x23\x22\x31\x5f\x82\x78\x6e\xe8\xf2\x66\xfd\x8e\xfd\xde\xd3\x5c\x5d\x6b\x99\xf3\xa3\x2c\xa4\x42\x01\x92\xa7\x02\x43\xe9\xdb\xcb\xc8\xd4\x50\x19\x38\x5b\xc5\x57\x28\x4a\x84\x33\xc2\x22\xc2\x16\xc5\x64\x66\x10\x84\x92\xb0\x45\x4a\x51\xb5\xbc\x50\x5d\xd0\x35\x64\x82\x30\x44\xe1\x65\xa0\x44\x0a\xe5\x85\x47\xc2\xf4\x57\xe1\xe7\x5c\xaa\xaa\xb0\x04\x31\x5b\x35\x1c\x14\x0b\x0f\x90\x85\x6f\x10\x7c\x37\xf5\xc3\xd2\x2a\xcc\xe3\x84\x33\x3d\x53\xa7\x90\x4a\x25\xb8\x9a\x37\x47\x54\x6e\xd9\x37\x2e\x45\xa7\x99\x68\xbd\x9d\x0b\x50\xe5\xc5\x20\xa4\x44\x2a\x3b\x93\xd9\x9c\x2c\x62\x94\xb8\xda\x6a\x42\x39\xce\xc4\xbe\xa2\xa4\xde\xc8\x02\x66\x71\x3d\x08\x23\xa0\xb0\xfd\x19\x73\x4a\x01\xeb\xa4\x12\x9e\xd4\xbb\x57\x7d\x4a\x56\xd3\x16\xba\x64\x9a\x44\x6f\xda\x78\xca\x2e\x5b\xc0\x00\x16\x25\x9c\x30\xe5\x83\xc5\xbf\xb6\xb4\x7a\xc6\x22\x9b\x71\xf6\x01\x42\x0b\xf5\x14\x02\x25\x31\x51\x02\xb1\x05\xf8\x20\xf1\x45\xab\x4d\xb4\x5a\x4f\x71\x54\x1e\x7a\x49\x6c\xb7\x65\x89\xe6\x34\xde\xc3\x67\x1e\xf9\xc9\xe3\xb7\x3c\x6a\xe1\xe9\x2f\xbd\xef\x89\x7e\x46\x4b\x05\x4c\x2d\x39\x4d\x63\xc0\x14\x91\xd8\x47\x8f\xb8\xab\x84\xbf\x65\xc2\x63\x2d\x7c\x24\x90\xbc\x04\xcd\x26\xa0\xbe\xb2\xe1\x91\x97\x70\xe1\x51\x7f\x01\x28\x88\x13\xaa\x67\xcf\xfd\x80\xb8\x2f\xe4\x7a\x0a\x44\x40\x42\x8b\x05\x58\xcc\x99\x12\x7a\x90\x23\x7c\xa0\x99\xac\x84\xc7\x95\x70\x6f\x21\xe5\x3f\x9b\x7e\xa6\x5c\x21\x3f\x70\x72\xc1\xff\x6a\xc1\x9e\x42\x91\x80\x05\x78\x19\x99\x4e\x33\xa5\xde\x62\x10\x4b\x82\x01\x61\xcc\x53\x3f\x03\xf5\x69\xae\x78\x95\x2b\xf6\x1b\x8b\x47\x1e\xcd\x41\x78\x75\x77\x54\xfc\xf9\x7a\x52\x3b\x7f\xb2\xe7\x95\x38\x8b\x69\x15\x94\x90\x86\x00\x8d\xa3\x97\xab\xbb\x9b\xd6\x10\xdf\x29\x9a\xda\xe0\x5d\x9b\x0d\x1b\x18\xb7\x63\xbc\x5a\xdf\x70\xe6\x4a\xd8\x42\x80\x94\x5e\xba\xfe\x4d\xae\x75\x0c\x44\xdf\xbc\x65\x69\x81\x71\x35\x1a\x13\xb0\x24\x6b\xaf\xc5\xb6\x02\xba\x1a\x8a\x4d\x0a\xd5\x83\x67\x6b\x7e\xbe\x44\x08\x62\xce\xa4\x9f\x01\xc8\x75\x26\x36\x05\xd5\x57\x18\x90\x50\xfe\x1c\x7b\x9a\x28\xbc\xae\xd4\x7a\x8a\xa3\xf8\x4d\xe3\x29\x38\x8a\x1f\x32\xfd\x8d\x0e\xa9\x90\x82\x79\x4a\x3d\xf1\x98\x16\x72\x7d\x00\x62\xf3\xa4\x33\xbe\xd1\x6d\x91\xa0\x7f\xb3\x89\xf9\x06\x28\xdd\xc6\x0d\x03\xcf\x4d\x9e\xbb\xdf\x8f\xb7\x40\xaa\xf8\x23\x30\x3d\x7c\x80\x27\x2f\x63\xdc\x7b\xad\x37\xc9\xf4\xf6\xf2\xf5\xe5\xac\x5b\x10\xf5\xcd\xe3\xda\x37\x08\x2d\x7c\xa5\x1c\x23\x2a\xd3\xd9\x0f\xc0\x0a\x61\x0c\x52\x36\x73\xdc\xd4\x87\xbe\x68\xed\x69\xae\x7d\x95\x69\x37\x81\x50\xf3\x3c\x02\x3a\xf7\x60\xb6\xf1\x7e\x4d\x81\xce\xbb\x37\x5b\xa4\x14\x3a\xb2\x7a\xa2\xa5\x3d\x1a\xdd\x15\xe7\xb6\x8c\x9b\xf7\x0f\xb7\x84\x30\x74\x92\xa1\x93\x1c\x79\x27\xd9\xda\x68\x64\xd1\x2d\xb2\x8e\xc5\x99\x42\x34\xe1\x51\xa9\xe1\x67\xe1\xe7\xdf\x95\xf4\x1d\x8f\xae\x2a\xe9\xbd\x9e\x6f\x0c\xc0\x36\x3f\x1f\xc8\x80\xcc\xbc\x25\x6d\x20\xfe\x6e\xc4\x2f\x06\xe2\x9d\x12\x7f\xbb\x3d\xcf\x02\xf3\x0f\x3e\xf3\x81\xf4\x3f\x7c\x76\x4c\xf8\xdc\x92\x03\x16\x9c\x79\xe2\x38\x16\x9c\x1d\x09\xcb\x5d\x5b\x1d\x6d\xa0\xae\x55\x27\x0b\x96\xad\x4b\xfc\x4c\x41\x2a\x2f\x0f\xf7\xf1\x4a\x7d\x9a\xab\x4f\x72\xf5\xe3\x25\xef\x18\xd3\x03\xfe\xa6\xf8\xeb\x37\xa7\xda\xaf\xbf\x61\x8a\x1a\x2c\xc2\x19\x59\x17\xab\x70\x63\xad\x78\xf0\x78\x4f\x76\x41\xf9\xad\x16\x25\xcd\x24\x8a\xd8\xca\xf6\x07\x13\x2f\x3c\x6e\x73\xc5\xbb\xb5\xcd\xd0\xc7\xd7\xfd\xdc\x72\xdf\xd0\x07\x87\x3e\x98\xf5\x41\x8b\x68\x33\xed\xd4\xb7\x08\xb1\x84\x47\x11\x91\x22\x4d\xb4\xf5\xb3\x34\x5a\xf8\x59\xdd\xbb\xe3\xd1\x75\x25\xfb\x39\x93\x3d\x78\xc8\xe6\x70\x4b\x78\x24\x01\xa7\x82\xa8\xe7\x86\xc9\xce\xd8\x11\xef\x78\x34\x2d\x64\x8f\x27\xe3\xed\x3f\xd1\xc0\x22\x22\x8b\x83\x05\x05\xa7\xd0\x70\x5b\xa0\x11\xf8\x38\x97\x9d\x70\x0a\xb6\x5b\x04\x37\x08\xbf\x3b\xf1\x93\x7d\x7c\x7c\x83\xe9\x29\x91\x16\xa1\x62\xca\x5d\xfd\x0f\x91\x26\xb1\x51\x47\xe2\xe0\x11\xb4\xcc\x4b\x6e\x8f\xcb\x21\x39\x0d\xc9\x69\x48\x4e\x43\x72\xf2\x98\x9c\x6a\x8e\x30\xb2\x49\x46\x92\x44\x82\x2c\x41\xf8\xe9\x6a\xd3\x9b\xeb\x4c\xed\xe0\xa1\x9a\xe3\x0a\x4b\xe2\x6d\x73\xef\x78\x7a\xd3\xe3\xfd\xbd\x45\x44\x79\x9c\x36\x98\xe6\x8a\x7d\x9e\x36\xc8\xb7\x3a\x23\xa5\x10\x7e\x68\xf2\x8a\xb3\x91\x4b\xbe\x8b\xf7\xaa\x12\x3d\x78\x36\x4d\xf2\x91\xe3\x08\x69\x48\x4a\x43\x52\x1a\x92\xd2\x90\x94\xbc\x26\x25\xab\x43\x08\x2d\xb2\x53\x9c\x2a\xa4\x08\x5b\x3c\xc1\xec\x81\xf3\xc7\xfc\xb8\xa4\x34\xdf\x01\xe8\x05\xfe\xd7\xa2\x81\xff\xe5\x0d\x8c\xd7\x1b\x38\xf8\x1b\x51\x13\xa4\x88\x92\xa8\x5b\x6a\xdf\xaa\x26\x7a\xc9\xad\x7d\x00\xbb\x3d\x63\x87\x28\x1e\xa2\xf8\xd7\x44\xf1\xae\x23\x4b\x2d\x02\x17\xa7\x52\xf1\xb8\x2c\x17\xc1\x9c\x30\xe2\x0d\xff\x38\x13\x2f\xcf\xc4\xb8\xae\xc4\x8f\x18\xbc\xe3\xd0\x7c\xa0\xdf\x8c\x7e\xfd\x09\xb3\x16\xd4\x13\x41\xb8\x5e\x3d\xf4\x38\xf2\xbd\x2b\x24\xfb\x31\xf4\x6d\x08\xd8\x2d\xb6\x07\xca\xfb\x29\xef\x3a\xdd\xd7\x02\x31\x05\x24\xbd\xcc\xdc\x7e\xd1\x42\xc7\x0b\xd4\x2d\x70\x07\xaa\x9b\x54\x6b\x0f\x66\xb6\xa0\x29\x52\xa6\x48\xec\x73\x96\x61\x92\x2b\x1e\x4d\x12\xd8\x7d\xfc\xb3\x05\xe2\xea\x54\x5d\x4a\xb0\x97\xc0\x2d\x8f\xd6\x9d\xd2\x5e\x9d\x7a\x33\x0a\xbe\x8f\x5e\xff\x1e\x00\x28\xef\x3c\xfa\x1c\x73\x00\x00"),
+		},
+		"/kubernetes-1.22.json": &vfsgen۰CompressedFileInfo{
+			name:             "kubernetes-1.22.json",
+			modTime:          time.Date(2026, 10, 17, 7, 15, 57, 328316567, time.UTC),
+			uncompressedSize: 24233,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9c\xcd\x6e\xdb\xb8\x16\xc7\xf7\x7e\x0a\x41\x6b\x37\x37\x35\xee\xa2\xc8\x2e\x75\xee\xc5\x64\x90\x06\x19\x2b\xe8\x00\x33\xe8\x82\xa6\x8e\x15\x36\x14\xa9\x92\x94\x33\x99\x20\xef\x3e\xa0\xbe\xfc\x45\xc9\x94\x4c\x65\x2c\x37\x40\x51\xc4\x16\xf9\xe7\x39\x3f\x1d\x1e\x51\x34\xc9\x97\x91\xe7\x2f\x41\x48\xc2\x99\x7f\xe1\xf9\xcb\x8f\xfe\x78\xe4\xf9\x98\xa6\x52\x81\xb8\x45\x31\xe8\x6f\x1f\xd3\x39\x08\x06\x0a\xe4\x87\x8f\x67\x93\x49\x56\x44\x82\x58\x82\xf8\xba\x5e\xf5\x6c\x32\x39\x3b\xcf\x2e\x62\x01\x48\x11\xce\xee\x49\x0c\x52\xa1\x38\xd1\x05\x26\xe7\x93\x8f\x1f\xce\x3f\x7d\x38\xff\xef\xfd\xf9\xf9\x45\xf6\xef\x8f\xac\x74\x24\x78\x9a\x48\xff\xc2\xfb\x73\xe4\x79\x2f\x23\xcf\xf3\x7c\x56\xb4\xac\xaf\x7b\x95\x85\x65\x99\xa2\x94\x57\x54\xdd\x30\x22\xaf\xe1\x6d\x7b\x95\x7d\xf9\xaa\xff\xff\x96\x15\xf0\x13\x01\x0b\x10\x02\xc2\x55\xed\x97\x51\xb3\xa6\x41\x52\x2b\xbe\x8e\x77\xcc\x46\x09\x11\x10\x11\xa9\x44\x86\xe1\xec\xf1\x93\x3c\x23\xbc\xad\x33\x66\x95\xff\xf4\xe1\xe3\xbe\xa6\xda\xb8\x9e\xc8\xf6\x8e\x26\xb2\x27\xb7\xd6\x85\xed\x9d\x80\x25\x30\x25\x3b\xde\xb6\x8d\xca\xfb\xdc\x1a\xb7\x92\x9a\x83\x42\x35\x7a\xf9\xa5\x03\x58\xd5\x98\x6d\x0f\x0d\xa5\xea\x01\x98\x22\x78\x3d\x84\x5a\x87\x82\x49\xa4\x9f\xd8\x68\x6e\xa9\x9d\xdf\x5c\x90\xbf\x0f\x75\x7b\x5b\xa3\x37\xaf\xeb\x1b\x6a\xe5\x34\x97\x18\x51\xc2\xa2\x0e\xbe\x96\x55\x0f\xeb\x1d\x1b\x42\x93\xfa\xbe\x51\x5c\xea\x26\x39\xa9\x97\x9c\x1c\x78\x23\x76\x29\xd8\xf3\x9f\x23\x85\x1f\xda\x92\xcf\x2a\x1d\xc6\xbc\x94\xe8\x33\x13\x6d\x99\x69\x0f\x05\x83\x50\x64\xa1\x7b\x34\x94\x89\xac\x2d\x22\x83\xc4\x3e\x60\x9d\x7c\x6c\x6c\xc7\xde\x63\x06\xea\x89\x8b\x47\xc2\xa2\x8e\xfe\xee\x08\xf4\xe2\x6d\x43\x2b\xf6\xbe\x26\x9c\x12\xfc\xdc\xd6\xc1\xbc\xd6\x61\x41\x5f\x69\xf4\x19\xf5\xdb\x86\xda\x83\x11\x73\x84\xcf\x4c\x89\xbd\x2d\xab\x5a\xa1\x5e\x82\xc2\xa2\x35\x7b\x06\x52\x71\x81\x22\xe8\xe8\xf8\x66\xed\xc3\x82\x65\x47\xab\xcf\xa0\xa9\x33\xdc\x1e\x1c\x0a\x63\x22\x75\x51\xc3\xf8\xbf\x2d\xc6\x26\xad\x5e\x42\xc8\xae\xc1\x16\x30\x12\x02\x7f\x29\x60\xba\xb4\xec\x0a\xc1\xa0\xd1\x8f\xf3\x8d\x0d\xd9\x3b\x2d\xf1\x03\x84\x29\x5d\x25\xe7\xb6\x1e\xef\x08\xf4\xe2\x6e\x43\x2b\xf6\xbe\x62\xce\x45\x48\xd8\x7a\x9c\xb4\xf5\xd6\x20\xd1\x8b\xbf\x8d\xed\xd8\x7b\xcc\x78\x08\x1d\x3d\x5d\xab\x7a\x58\x4a\xdc\x14\xea\x33\x1f\x1a\x4d\xb6\x87\x15\x12\x89\xf9\x12\xc4\x73\x47\x62\xdb\xf5\x0f\xc3\x66\x50\xeb\x93\x5d\xbd\xf1\xf6\x00\x17\x94\x3f\x61\xce\x94\xe0\xf4\x0c\x25\x24\x9f\x24\xec\x08\xb3\x49\xab\x67\x14\x2d\x9a\xae\x6b\x59\xdf\x83\xd7\x51\x76\x17\x7c\x01\x92\xa7\x02\x43\xe9\xf3\xcb\xc8\xd4\x68\x89\x7b\xa7\xf8\x0a\x51\x89\x79\x4e\x58\x48\x58\x54\x4c\xb4\x79\x9e\x2f\x09\x8b\x52\x8a\xaa\x19\xdb\xea\x82\xae\x21\x13\x84\x21\xf4\x2f\x3c\x25\x52\x28\x2f\x3c\x12\xa6\xbf\xf2\x3f\xe7\x52\x55\x85\x25\x88\xf9\xaa\x61\xaf\x98\xcb\x85\xec\xa6\x7b\xde\x37\x53\xdc\x96\x56\x61\x1e\x27\x9c\xe9\x59\x24\x85\x54\x2a\xa1\xad\x79\x0b\x44\xe5\x8e\x7d\xd3\x52\x34\xc8\x44\xeb\xed\x8c\x40\x95\x17\x3d\x9f\x12\xa9\xec\x4c\x66\x0b\x12\xc5\x28\x69\x6b\xab\x09\xe5\x34\x13\xfb\x82\x92\x7a\x23\x0b\x98\xc5\x75\xcf\x0f\x81\xc2\xee\x67\xcc\x29\x05\xac\x13\xbf\x3f\xae\x77\xaf\xfa\x94\xac\xe6\x02\x74\xc9\x34\x09\x37\xda\x78\xca\x2e\x5b\xc0\x00\x16\x26\x9c\x30\xe5\x82\xc5\xff\x76\xb4\x06\xc6\x22\x9b\x0d\x75\x01\x42\x0b\x0d\x14\x02\x25\x31\x51\x02\xb1\x08\x5c\x90\xb8\xd1\x6a\x33\xad\x36\x50\x1c\x95\x87\x4e\x12\xdb\x6d\x59\xa2\x3b\x8d\xb7\xf0\x99\x87\x6e\xf2\xf8\x2d\x0f\x0f\xf0\xf4\x5f\xbd\xef\x89\x7e\x46\x4b\x05\x4c\x2d\x39\x4d\x63\xc0\x14\x91\xd8\x45\x8f\xb8\xab\x84\xbf\x66\xc2\x53\x2d\x7c\x22\x90\x9c\x04\xcd\x36\xa0\xa1\xb2\xe1\xa1\x93\x70\xe1\xe1\x70\x01\x28\x88\x13\xaa\x67\xbb\xdd\x80\xb8\x2f\xe4\x06\x0a\x44\x40\x42\x8b\x5f\x1e\x8b\xf7\x0c\x0a\xc2\x05\x9a\xd9\x4a\x78\x5a\x09\x0f\x16\x52\xfe\xda\xf4\x23\xe5\x0a\xb9\x81\x93\x0b\xfe\xa6\x05\x07\x0a\x45\x02\x16\xe0\x64\x64\x1a\x64\x4a\x83\xc5\x20\x96\x04\x03\xc2\x98\xa7\x6e\x06\xea\x41\xae\x78\x99\x2b\x0e\x1b\x8b\x43\x1e\xdd\x41\x38\x75\x77\x54\xfc\xf9\x3a\xae\x9d\x3f\xd9\xb3\x86\xc9\x62\x5a\xa5\x98\xea\x21\xd8\xcd\xe8\xe5\xf2\xee\xfa\x60\x88\x6f\x14\x4d\x56\x78\x13\xd9\x06\xe6\xea\xb9\x26\x60\x49\xf4\x24\x97\x8b\xa8\x5c\x3d\xd4\x66\x85\xea\xd1\xb3\x35\xf7\xd4\x10\x41\xcc\x99\x74\x93\xca\xaf\x32\xb1\x00\xd4\x50\x61\x40\x42\xf9\x73\xec\x68\xca\xe5\xaa\x52\x1b\x28\x8e\x62\x74\xe8\x28\x38\x8a\x21\xe1\x70\xa3\x43\x2a\xa4\x60\x91\x52\x47\x3c\x82\x42\x6e\x08\x40\x2c\xf2\xb2\x79\xdd\xa6\x45\x82\xfe\xc9\xa6\x38\x3b\xa0\x5c\xfb\x9d\xe7\x9d\x67\x07\x9e\xcd\x4b\x6c\x2d\x90\x2a\xfe\x08\x4c\x0f\x1f\xe0\xc9\xc9\x88\xec\x5e\xeb\xcd\x32\xbd\xbd\x7c\xbb\x38\x5b\xbb\x92\xc9\xc2\x57\xca\x31\xa2\x32\x9d\x7f\x07\xac\x10\xc6\x20\x65\x37\xc7\x4d\x11\x75\xa3\xb5\x83\x5c\xfb\x32\xd3\xee\x02\xa1\x26\x3b\x03\x5d\x38\x30\xdb\x78\xbf\x02\xa0\x8b\xfe\xcd\x16\x29\x85\x9e\xac\x9e\x69\x69\x87\x46\xf7\xc5\xf9\x50\xc6\x76\xfd\x63\x67\xc1\xb3\x45\xb7\xc8\x56\x07\x72\xa6\x10\x4d\x78\x58\x6a\xb8\x99\x2b\xfb\xa5\x92\xbe\xe3\xe1\x65\x25\xbd\xd7\xf3\xad\x4c\xbb\xfd\xf9\x48\x32\xaf\x79\x69\xfc\x3b\xf1\x37\x23\x3e\x79\x27\xde\x2b\xf1\xcd\x6d\x02\x16\x98\xb1\xe0\xec\x3b\x9f\xbb\xc0\x3a\x15\x9c\xfd\xca\xe7\x47\x8f\xd1\xfc\x18\x71\x04\x61\x08\x00\x5a\xc4\x51\xbb\x2c\xf9\x13\x06\x93\x05\xcb\xa6\x6d\x2d\x36\x50\xd7\xaa\x93\x88\x11\x16\x09\xf8\x91\x82\x6c\xfd\x5a\x65\x1c\xe5\x4c\x57\xea\x41\xae\x3e\xcb\xd5\x4f\x81\x7c\xfd\x16\x1b\x0b\xee\x19\x68\x29\x31\x45\xd2\xd1\xb2\xbd\xeb\x5c\x71\xaa\x15\x8f\x1e\xef\xb8\x09\x0a\xb8\xe8\xe2\x05\x8e\x81\x92\x28\x62\x2b\xdb\xa1\x44\x9c\xf0\xb8\xcd\x15\xef\xd6\xb6\x74\x0d\xbb\xfb\x6d\xed\xde\xb2\xe8\x73\x09\x0f\x43\x22\x45\x9a\x68\xc3\xe7\x69\x18\xb9\x99\xdd\xbc\xe3\xe1\x55\x25\xfb\x39\x93\x3d\x29\xbe\xed\x9e\xd2\xef\x90\x2b\xc8\xe6\xae\x9d\xf0\x50\x02\x4e\x05\x51\xcf\x1d\xbb\xb7\x31\xfd\xdf\xf1\x30\x28\x64\x4f\xa7\x8f\xef\xdf\xb0\x68\x11\x91\xc5\xd1\x2a\x82\x53\xe8\xb8\x8a\xdf\x08\x7c\x9a\xcb\xce\x38\x05\xdb\x15\xfd\x5b\x84\xdf\x9c\xf8\x78\x1f\x1f\xd7\x60\x06\x4a\xe4\x80\x50\x31\xe5\xae\xe1\x87\x48\x97\xd8\xa8\x23\x71\xf4\x08\x2c\xf2\x52\xcd\x26\x60\x9b\x64\x24\x49\x28\xc8\x12\x84\x9b\xae\x16\x5c\x5f\x65\x6a\x47\x0f\xd5\x1c\x57\x58\x12\x67\xcb\xdd\xa7\xc1\xf5\x80\x57\xbc\x17\x11\xe5\xf0\x7d\x30\xc8\x15\x87\xfc\x3e\x98\x2f\xfe\x47\x4a\x21\xfc\xd0\x65\xa9\x8a\x91\x4b\xbe\xae\xfd\xb2\x12\x3d\x7a\x36\x5d\xf2\x51\xcb\x99\x35\xbd\x8f\x20\x8b\x16\x8c\x12\x84\x89\x72\xf3\xca\x39\x0d\xae\xcb\x20\xcc\x65\x4f\xe2\xbd\xd3\xea\x04\x04\x0b\xe8\x71\xaa\x90\x22\x2c\x7a\x82\xf9\x03\xe7\x8f\xf9\xbe\xc8\x34\x3f\x0d\xc2\x49\xa0\x7f\x29\x1a\xf8\x3d\x6f\x60\xba\xde\xc0\xd1\xdf\x88\x9a\x84\x80\x28\x09\xfb\xa5\xf6\xb5\x6a\x62\x90\xdc\x6c\x02\xb8\xe9\x14\x0b\x9b\x6c\x91\x4a\xc5\xe3\xb2\x5c\x08\x0b\xc2\x88\x33\xfc\xd3\x4c\xbc\xdc\x36\x70\x55\x89\x9f\x02\xf8\xfa\xf3\x34\x2c\xa8\x27\x82\x70\x3d\x67\xe0\x70\x84\x70\x57\x48\x0e\x63\x88\x60\x01\xb8\xe9\x00\x0f\x0b\xc4\x14\x90\x74\xf2\xe0\xbb\xd1\x42\xa7\x00\xd4\x74\xb8\x87\x05\x48\x91\x32\x45\x62\x70\x18\xaa\xb3\x5c\xf1\x64\x22\x75\x13\x6c\xbb\xd1\xda\x3b\xdd\x7d\x74\x6b\xcf\x55\xb1\xa0\x5b\x1d\xc9\x40\x1d\xed\xea\x29\xcf\x65\x08\xe8\x89\x6c\x4b\x31\xd0\x6d\x17\xc0\xef\x88\xf7\x21\xb6\x3f\x0d\xc7\x02\xb7\x16\xd3\x43\x8f\x18\x39\x49\x16\xff\xa7\xfc\x29\xc8\xe4\x8e\x9e\xf4\xb8\x71\x24\x45\x61\x09\xd4\xfd\x0b\x44\x39\xaa\xba\xd1\xf2\x03\x7d\x79\x18\x79\xdf\x46\xaf\xff\x0c\x00\xfc\x54\x52\x22\xa9\x5e\x00\x00"),
+		},
+		"/openshift-4.6.json": &vfsgen۰CompressedFileInfo{
+			name:             "openshift-4.6.json",
+			modTime:          time.Date(2026, 10, 17, 9, 0, 29, 776694345, time.UTC),
+			uncompressedSize: 57923,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x5d\x73\xdc\xb6\xd5\xc7\xef\xf5\x29\x76\xf6\x5a\xd1\xe3\x68\x9e\xe9\x8b\xef\xe4\x75\x1a\xab\x8d\x15\x45\xeb\xa6\x33\xed\xe4\x02\x0b\x42\xbb\x88\x48\x80\x01\x40\xd9\xae\xc7\xdf\xbd\x03\x10\xe4\xbe\x81\x5c\x10\x3c\x54\x16\x0c\x67\x3a\x9d\x58\x4b\xfc\x81\xf3\xe3\xc1\x21\xde\xf1\xe5\x62\x36\x7f\x26\x42\x52\xce\xe6\xaf\x67\xf3\xe7\x6f\xe7\x97\x17\xb3\x39\x4e\x0b\xa9\x88\xb8\x43\x19\xd1\x7f\xe5\x39\x61\x72\x43\x1f\xd5\x37\xff\x7f\xf5\x27\xf3\x80\x24\xe2\x99\x88\x9f\x77\x13\x5e\x7d\xfb\xd7\xab\x57\xe6\x47\x2c\x08\x52\x94\xb3\x0f\x34\x23\x52\xa1\x2c\xd7\x0f\x5c\xbf\xba\x7e\xf5\xcd\xb7\xaf\xbe\xb9\xfe\xf3\x87\x57\xaf\x5e\x9b\xff\xfd\xdb\x3c\xbd\x16\xbc\xc8\xe5\xfc\xf5\xec\x3f\x17\xb3\xd9\x97\x8b\xd9\x6c\x36\x67\x36\x5f\xfd\xfb\xac\x2e\x5f\xf5\x8c\x7d\x6a\x66\x93\xee\x15\xa2\x4c\x31\x3b\xb4\xc9\xfc\xf1\xab\xfe\xff\x5f\xcc\x03\xf3\x5c\x90\x47\x22\x04\x49\xb6\xa9\xbf\x5c\xb4\x6b\x3a\x24\xb5\xe2\xd7\xcb\xa3\x62\xa3\x9c\x0a\xb2\xa6\x52\x09\x83\xe1\xea\xe9\x2f\xf2\x8a\xf2\xae\xc6\xb8\x55\xfe\xef\x84\x8d\x97\x61\x9a\x2b\xa2\x50\x83\x70\xf9\x53\x0f\x82\xa7\x0c\xf1\x07\x4b\x3e\x29\xc2\xf4\xa3\xb2\x2b\xcc\x6d\xca\x81\x8d\x6d\xcc\xa8\x29\x9f\x46\x1f\xca\x3b\x1b\x89\xf2\x5c\x9e\xf2\x8f\xc0\x37\xb8\x2b\xdc\xe1\x7d\x3d\x13\xa6\xa4\x7d\xe1\x9d\x5f\xd9\x6e\xe2\x7e\x6e\x7f\x28\x35\xa8\x03\xb8\x8b\xed\x0f\x0d\x15\x6a\x43\x98\xa2\x78\xb7\xb6\x74\x76\x05\x97\x48\x3f\x88\xce\x72\x0d\x0c\xf3\x84\x19\xdd\xa0\x72\x41\xff\xdb\x97\xe9\xa1\x46\x7f\xa4\x0e\xc5\xa1\x89\x36\x1b\xd1\x09\x28\x97\x18\xa5\x94\xad\x03\x38\x56\x49\x7b\xe3\xdb\x0a\x5d\x37\x53\xb3\x3f\x85\x49\x5e\x37\x4b\x5e\xf7\x7c\x11\xc7\x14\xfc\xf9\xaf\x90\xc2\x9b\xae\xe4\x4d\xa2\x7e\xcc\x2b\x89\x21\x7d\xf4\xa0\x98\xfe\x50\x30\x11\x8a\x3e\xea\xa0\x47\xaa\x08\xdc\x15\x91\x43\xa2\x1f\x30\xb7\xe0\x90\xf8\x5a\x4d\xf0\x87\xc9\x88\xfa\xc8\xc5\x13\x65\xeb\x40\x94\x47\x02\xfd\x40\xba\xe4\x86\xc4\xd8\x52\x7c\x7f\x88\x39\x4f\x29\xfe\xdc\x95\x5c\x99\x6a\x60\xfb\x9c\x99\x34\xe5\xd1\x60\x9e\x58\x21\x7c\xe5\xfa\xa4\x74\xb5\xb8\x51\xa8\x9f\xcf\xb4\xc9\x0e\xc9\xd6\xc3\x1c\x7f\x1f\x92\x8a\x0b\xb4\x26\x81\x64\xf7\x53\xf7\xc3\x79\xa4\x35\x24\xc3\xa6\x82\xfb\x83\x43\x49\x46\xa5\x7e\xd4\xd1\xfd\xec\x8a\xb1\x4d\xab\x1f\xd4\x13\xca\x43\x22\xf6\x33\xaa\x03\xf0\x9c\x6e\xfb\xbf\xa1\xa0\x1d\x1a\x3d\x01\xbb\x15\x07\x05\xdb\x6a\x84\x3f\x50\x89\x37\x24\x29\xd2\xed\x37\xa8\x2b\xcd\x23\x81\x7e\x28\x5d\x72\x43\x72\x6c\x29\xbe\x3f\x44\xcc\xb9\x48\x28\xdb\x75\xee\xae\x18\x1d\x12\xfd\x40\xba\x05\x87\x44\xd9\x6a\x82\x3f\x4c\xc6\x13\x12\x08\x71\x27\xe9\xc0\xb6\x36\xe7\xd4\x94\x51\x83\xb5\x09\x95\x98\x3f\x13\xf1\x39\xd0\xe4\xc3\xf4\x03\xdb\x7d\x22\xbb\x8e\xc6\xeb\xe1\xc4\xab\x7a\xe8\x3f\xc0\xfa\x23\x81\x53\x75\x26\xc8\xe8\x96\x5c\x1c\x99\x34\xd9\xba\xd7\x56\xeb\x65\x74\xa3\xd2\x30\xd6\x7b\x64\xe7\x8f\x61\x55\xd0\x34\xe9\x65\xfe\xb1\xc2\x20\x66\xb7\x65\xe3\x6f\x2e\xe6\xec\x91\xae\x7b\xd9\xeb\x90\x18\xc4\xe0\xd6\x7c\xfc\x2d\xa6\x99\x6e\x52\xf7\x31\xf8\x58\x61\x10\x7b\xdb\xb2\xf1\x37\x37\x43\x78\x43\x59\x3f\x83\x5d\x1a\x03\xc7\x71\x8f\x2c\x9b\x72\x6c\x07\x51\x7a\x51\x61\x1b\xfa\x00\x54\x9a\x05\x07\xf1\x8a\x0e\xb9\x76\x70\x12\xce\xa8\xe2\x42\x37\xb2\x31\x17\x84\xcb\x2b\xcc\xb3\xce\x3c\x5c\x22\xc3\x30\x68\xcf\xc9\xdf\x6e\x3b\xc0\xd5\xcb\x0d\x5c\x1a\x83\x58\xdd\x9e\x91\xbf\xd1\x5c\x8f\x56\xf5\x32\xf9\x58\x61\x10\x83\xdb\xb2\xe9\x60\x6e\x4e\x04\x52\x5c\xf4\xb3\xd8\x25\xd2\xaf\xff\xd3\x24\x89\xd2\x7c\xd3\x14\x56\xed\x6f\x7d\x98\xb6\x1b\xd2\x1d\xab\xdc\xa9\x82\xa1\x54\x77\x35\x60\xa0\x1e\x28\xbe\x0c\x53\xb7\x19\xfe\x48\x73\xc1\x7f\x25\x58\xf5\x72\x54\x97\xc6\x29\xa4\x41\x46\xb7\x67\xe4\x6f\xf4\x6f\x05\x57\xa8\x97\xc9\xc7\x0a\x83\x18\xdc\x96\x8d\xbf\xb9\x82\x17\x8a\xf4\x32\xf7\x58\x61\x10\x73\xdb\xb2\xf1\x37\x57\x12\x5c\x08\xaa\x3e\xf7\xb2\xd8\x29\x32\x88\xd1\x27\x72\xea\x60\x37\x43\xb9\xdc\x70\x75\xb5\x3f\x84\xdf\xd9\x72\xb7\xcc\xc0\x6d\x6f\xbf\x5c\x9b\x32\x6d\x20\xa2\x48\x96\xa7\x48\x91\x5e\x9e\xe0\x14\x19\xc4\x13\x4e\xe4\xe4\xef\x09\x85\x24\xa2\x97\xcd\x47\x02\x83\xd8\xdb\x92\x8b\x23\x13\xf3\x8e\x2f\xcc\x97\x72\x2e\x88\xe4\x85\xc0\xa4\xb2\xe4\xcb\x85\x4b\xbf\x52\x3b\x7a\x7c\x6b\x78\x45\x6c\x45\x59\x42\xd9\xda\xae\xfd\x9b\xcd\xe6\x92\xb2\x75\x91\xa2\x7a\x2d\x6e\xfd\x83\x4e\x21\x73\x84\x49\x32\x7f\x3d\x53\xa2\x20\xd5\x0f\x4f\x94\xe9\x3f\xcd\xdf\x94\x52\x75\x82\x67\x22\x56\xdb\x8c\x67\x76\x9d\x2e\x31\x36\xcd\x66\xbf\xb8\x5a\x18\x55\xa9\x30\xcf\x72\xce\xf4\xc2\x36\x85\x54\x21\x49\xd7\xe2\x3d\xa2\x54\x1e\x95\x6f\x51\x89\x2e\x8d\x68\x73\x39\xd7\x44\x55\x3f\xce\xe6\x29\x95\xca\xaf\xc8\xba\x67\x9a\xa1\xbc\x6b\x59\x5d\x28\x17\xa6\xc3\xf9\x1e\xe5\xcd\x85\xb4\x30\xed\xef\xb3\x79\x42\x52\x72\xfc\x6f\xcc\xd3\x94\x60\xdd\x6d\x9d\x5f\x36\x9b\x57\xff\x2b\xdf\xae\xf2\xd1\x4f\x16\x79\xb2\x97\xc7\x47\xf3\xb3\x07\x0c\xc2\x92\x9c\x53\xa6\x20\x58\x7c\x77\xa4\x15\x19\x0b\xb3\x40\x13\x02\x84\x16\x8a\x14\x42\x4a\x33\xaa\x04\x62\x6b\x02\x41\xe2\x07\xad\xf6\xa0\xd5\x22\xc5\x51\x5b\x08\x12\xd8\xee\xaa\x27\xc2\x69\xbc\x84\xcd\x3c\x81\x89\xe3\x77\x3c\xe9\x61\xe9\xef\xfa\xde\x73\xfd\x8d\x96\x8a\x30\xf5\xcc\xd3\x22\x23\x38\x45\x34\x83\xa8\x11\xf7\xb5\xf0\xcf\x46\x78\xa1\x85\x47\x02\x09\xc4\x69\x0e\x01\xc5\xca\x86\x27\x20\xee\xc2\x93\x78\x01\x54\xbd\x05\x20\x10\x1f\xac\x5c\xa4\x40\x04\xc9\x53\xbb\x5f\x01\x73\xa6\x84\x6e\xe4\x08\x08\x34\x0f\x5b\xe1\x45\x2d\x1c\x2d\xa4\xb2\xdb\x64\x86\x95\x60\xe0\x94\x82\x3f\x69\xc1\x48\xa1\x48\x82\x05\x01\x69\x99\x2e\x8d\x52\xb4\x18\xc4\x33\xc5\x04\x61\xcc\x0b\x98\x86\xfa\xb2\x54\xbc\x29\x15\xe3\xc6\x02\xc8\x23\x1c\x04\xa8\xb9\x17\xf6\x3f\xbf\x5e\x36\x8e\x9f\x9c\xd8\x41\xea\x31\xac\x82\x72\x1a\x08\xd0\xd9\x7a\xb9\xb9\xbf\xed\x0d\xf1\x85\xbc\xa9\x0f\xde\x9d\x11\xcf\x89\x71\x3f\xc6\xdb\xe5\xc0\x9d\xb9\x52\xb6\x16\x44\x4a\x90\xaa\x7f\x5b\x6a\x8d\x81\xe8\xde\xa6\x64\x0f\x8c\xdb\xd6\x98\x20\xcf\x74\x67\x17\x79\x2f\xa0\xdb\xa6\xd8\x83\x55\x3d\x7b\xb6\xee\xef\x4b\x82\x48\xc6\x99\x84\x69\x80\xbc\x35\x62\x4b\xa2\x62\x85\x41\xf2\x94\x7f\xce\x80\x06\x0a\xdf\xd6\x6a\x91\xe2\xb0\x7d\x1a\x20\xe7\xb0\x1d\x99\x78\xbd\x43\x2a\xa4\xc8\x63\x91\x02\xf1\x58\x5a\xb9\x18\x80\xf8\x7c\xe9\x9c\x07\x20\x78\x04\xe8\x3f\xd8\xc0\x7c\x00\xca\x6e\xed\x86\x89\xe7\x21\xcf\xf6\xe3\x24\x3c\x90\x2a\xfe\x44\x98\x6e\x3e\x90\x8f\x20\x6d\xdc\x0f\x5a\xef\xc1\xe8\x9d\xe4\x0b\x65\x6c\x37\x27\x8a\xcd\xe2\xed\x5e\x88\xee\x6f\x37\xe5\x18\xa5\xb2\x58\xe9\x75\x68\x08\x63\x22\x65\x98\xe1\xae\x3a\xf4\x83\xd6\x5e\x96\xda\x37\x46\x3b\x04\x42\xc3\xf7\x88\xa4\x8f\x00\xc5\x76\xbe\xaf\x25\x49\x1f\x87\x2f\xb6\x28\x52\x32\x50\xa9\x1f\xb4\x34\x60\xa1\x87\xe2\xdc\x97\x71\x78\xfd\xe8\x16\x10\xa6\x4a\x32\x55\x92\x91\x57\x92\xa3\x73\x79\x3c\xaa\x85\xa9\x58\x9c\x29\x94\xe6\x3c\xa9\x34\x60\x26\x7e\xde\xd5\xd2\xf7\x3c\xb9\xa9\xa5\x4f\x5a\x7e\xd0\x00\x3b\xfc\xf7\x99\x34\xc8\xdc\x27\x38\x4d\xc4\x5f\x8c\xf8\xf5\x44\x7c\x50\xe2\xfb\xa7\x59\x79\x60\xfe\x95\xaf\x20\x90\xfe\x9d\xaf\xc6\x84\xaf\x5b\x70\xc0\x82\x33\x20\x8e\x0b\xc1\xd9\x48\x58\xb6\x9d\x0c\xe6\x03\x75\x27\x39\x5d\x33\x33\x2f\xf1\x5b\x41\xa4\x02\xf9\xb8\x2f\xb6\xea\xcb\x52\xfd\xa1\x54\x1f\x2f\xf9\x8e\x3e\x3d\xe1\x0f\xc5\x6f\x77\x8f\x52\xb6\x0e\x70\x7b\x3b\xff\x86\x53\x14\x30\x09\xe7\x64\x6d\x67\xe1\x16\x5a\xf1\xec\xf1\x5e\xb6\x41\xf9\x43\x4d\x4a\xba\x49\x58\xdf\x32\xc7\xe9\x51\x10\x1e\x77\xa5\xe2\xfd\xce\xd9\x81\xe3\xab\x7e\xdd\x62\xdf\x54\x07\xa7\x3a\x68\xea\xa0\x87\xb7\xb9\x0e\xb6\xf4\x70\xb1\x9c\x27\x09\x95\xa2\xc8\x75\xe9\x57\x45\xb2\x86\x99\xdd\xbb\xe7\xc9\xdb\x5a\xf6\x8d\x91\x3d\x7b\xc8\x6e\x77\xcb\x79\x52\x6d\x11\x0d\x0c\x76\xce\x8a\x78\xcf\x93\xa5\x95\x1d\x4f\xc4\x3b\x7d\x00\xa8\x87\x47\xda\x5b\x38\x04\x4f\x49\xe0\xb6\x40\x27\xf0\x45\x29\xfb\xc0\x53\xe2\xbb\x45\xf0\x80\xf0\x8b\x13\xbf\x3c\xc5\x07\x1a\x4c\xa4\x44\x7a\xb8\x8a\x2b\x76\xc5\xef\x22\x21\xbe\xd1\x44\xe2\xec\x11\xf4\x8c\x4b\xdd\x3e\x97\x53\x70\x9a\x82\xd3\x14\x9c\xa6\xe0\x04\x18\x9c\x8e\x4e\xdc\xf0\x0f\x46\x92\x26\x82\x3e\x13\x01\x53\xd5\x96\xb7\x6f\x8d\xda\xd9\x43\x75\xfb\x15\x96\x14\x6c\x73\xef\x62\x79\x1b\xf1\xfe\x5e\xeb\x51\x80\xc3\x06\xcb\x52\x31\xe6\x61\x83\x72\xab\x33\x52\x0a\xe1\x4d\xc8\x12\x67\x27\x97\x72\x17\xef\x4d\x2d\x7a\xf6\x6c\x42\xe2\x51\xc7\x16\xd2\x14\x94\xa6\xa0\x34\x05\xa5\x29\x28\x81\x06\x25\xaf\x3b\x3b\x3c\xa2\x53\x56\x28\xa4\x28\x5b\x7f\x24\xab\x0d\xe7\x4f\x7b\x47\xea\x82\xc0\x7f\x6f\x33\xf8\x57\x99\xc1\x62\x37\x83\xb3\x7f\x11\x0d\x4e\x8a\x52\x9a\x0c\x4b\xed\xe7\x3a\x8b\x28\xb9\xf5\x77\xe0\x6e\xdf\xd8\xc9\x8b\x27\x2f\xfe\x7d\xbc\xb8\xed\x86\x1f\x0f\xc7\xc5\x85\x54\x3c\xab\x9e\x4b\xc8\x23\x65\x14\x0c\xff\xc2\x88\x57\x67\x62\xbc\xad\xc5\x47\x0c\xbe\x63\xd3\x7c\xa2\x1f\x46\xbf\xf9\x42\x26\x0f\xea\xb9\xa0\x5c\xcf\x1e\x02\xb6\x7c\xef\xad\x64\x1c\x4d\xdf\x40\xc0\xdd\x7c\x7b\xa2\x7c\x9a\x72\xdb\x65\x58\x1e\x88\x53\x82\x24\xc8\xc8\xed\x0f\x5a\x68\xbc\x40\xbb\x39\xee\x44\xf5\x90\x6a\xe3\x3d\x66\x1e\x34\x45\xc1\x14\xcd\x20\x47\x19\x1e\x4a\xc5\xd1\x04\x81\xf6\xdb\xd2\x3c\x10\xd7\xa7\xea\xa6\x14\x83\x38\x6e\x75\xb4\xee\x32\x1d\xcd\xc9\x42\x4d\x77\xb3\x79\xe0\xdd\x1e\xc2\x51\x76\xb3\x21\x08\x6f\x8f\xe2\x28\x7b\x24\xa3\x80\xbc\x37\x9d\x1f\x4a\x7b\x67\x76\x56\x10\xa9\x04\xc5\x21\x0d\xe2\x13\x13\xb5\x0f\x5b\xe5\x31\x90\x6f\xb9\x85\xce\x83\xb8\x49\x0d\xe7\xda\x6f\xb4\x5c\x24\x5e\xed\x1e\x98\x30\x40\xc0\x50\x9c\x3d\x04\x0f\x07\x6b\xbb\xf5\xcf\xff\x44\x36\x22\x20\x0f\x64\x8b\x76\x06\x6a\xff\x40\x0c\x18\x24\x7b\x92\x91\x72\x09\xaa\x76\x4e\x1c\x71\xd4\xbb\xd6\xf5\x5a\xf5\x75\x56\x10\x3c\xec\x9a\xad\x1f\xad\x66\xdc\x64\xec\x45\x28\x90\x60\xaa\x48\x17\x29\x17\xce\x24\xd8\xea\xbe\x52\x2b\x52\x12\x09\x03\xea\x62\xbe\xbd\x5b\x46\x8a\xe0\x91\x20\x55\x08\xb2\x46\x0a\x86\xc4\xdf\x4a\xbd\xef\x91\x8a\xd5\x29\xcc\x0d\xbe\x20\x2c\x6e\xb5\x52\xac\x14\xd8\xa3\x40\x52\x89\x02\xeb\xd7\x09\x83\x63\x4f\x32\x5a\x2e\x61\x7b\xd7\x1a\x88\x8c\x60\x03\x29\x08\x09\xbb\x75\x34\x52\x12\xe6\xce\x5b\x10\x0e\x3f\xde\x14\x6a\x13\x2b\x05\xdb\x5c\xdc\x14\x2b\x18\x16\x56\xef\x5d\xb1\x8a\x94\x88\xbd\x6e\x15\x84\xc6\x7d\xa9\x15\x2f\x89\x4f\x50\xfb\x2e\x05\xff\xf4\x39\x52\x0c\x76\x96\x14\x68\x60\x63\x59\xa9\x9d\x3d\x0d\x8f\x21\x23\xd3\xec\x0a\x1e\x31\x9a\x1a\x6d\xa6\xd1\x66\x28\x28\x41\x50\x06\x31\x1c\x69\x50\x2c\x8d\x5c\xfc\x40\x14\x5a\x03\x33\xf9\x80\x7a\x0c\x58\x77\x36\xbe\x6b\x7d\xca\x10\xde\x50\x76\x74\x15\x6e\xb7\x99\x42\x2b\xb2\x21\x28\x55\x1b\xbc\x21\xf8\x09\x82\xe1\xfb\x52\xf5\x9d\x51\x5d\x68\xd5\x70\x8e\xbf\xab\x7b\x59\x3c\x80\x4c\x22\x07\x41\x14\x20\x8b\x91\x9c\x2b\x6f\xd9\xec\x2d\x56\x0d\xfe\xce\xe9\xcb\x40\x10\x65\x44\x54\xcb\x24\x82\xa6\xe1\x9c\x1f\xbe\x45\x25\x5d\xad\x97\x88\x79\x4a\x6e\x7b\x69\x0a\x30\xa1\x52\x34\x6a\x36\x4f\xc5\x4a\xbf\x1a\x40\x30\xff\x28\x15\xa3\xa6\xb2\x57\x4d\x73\xce\x53\x10\x32\x36\x94\x95\x64\xee\x39\x4f\xc7\x40\x07\x9e\xcc\xd9\x53\xf1\x89\xf3\x9c\x51\xc5\x85\x5e\x01\x8b\xb9\x20\x5c\x5e\x61\x9e\x75\x89\xed\xfa\xe0\x58\x95\x21\x86\xd6\x44\x40\x7c\x46\x6f\x76\xf4\xce\x1e\xb0\xdb\xed\x72\x9e\x58\xac\x10\x40\xee\x79\xf2\xbe\x54\x8b\x15\x87\xe0\x19\x51\x1b\x52\x48\x02\xc2\xa3\x96\x8b\x9e\x87\x39\x63\x1e\x14\x89\xbe\x7f\x21\x52\x2c\xf6\x36\x43\xc0\x9a\x63\x2f\x34\x8c\xa5\xf6\xf8\x1f\xed\x18\xde\x10\x2f\x57\x02\x58\x19\x90\x2f\xa2\x5d\x5c\x10\xf7\x54\x08\x31\x33\x5a\xf0\x67\x8b\x7e\x67\x74\xe3\x3a\x61\xd4\x4d\x68\xc3\xa5\x92\xc5\x8a\x11\x05\xe2\x35\xef\xb8\x54\x4b\x23\x17\x29\x0f\x46\x54\x6d\x25\x08\x91\x3b\xa2\xee\xaa\x87\xce\x9e\x89\x47\xa8\x32\xb3\x8b\xc1\x81\xca\xa4\xc6\x29\x85\x3a\x9b\xc0\xcc\x50\x2e\x8c\xde\x28\xe0\xda\x49\xc6\x60\xbe\xd3\xea\x4c\xf7\xea\x4c\x9c\xf2\x22\xc1\x82\x24\x1a\x0e\x82\xe9\x4d\x2f\xb4\xe6\xa2\xd6\x8c\x95\x0c\x5c\x27\x3a\xea\x11\x97\x69\x1d\x62\xb5\x0e\x11\x4b\x2a\x19\xca\xe5\x86\xab\xed\xc0\x25\x0c\x97\xe5\xed\xd2\x2a\x6f\x47\x2f\x23\xa5\xf4\x07\x5f\xad\xa9\x57\x6b\x12\x85\x61\x56\xbc\x7f\xa7\x70\x12\xf7\x0a\xbc\xf0\x8a\xd2\x72\x8c\x7c\xf4\x75\x44\x8f\xed\xc3\xee\x9e\xd1\x63\xfb\xb1\xef\xa0\xd1\x54\xb6\xfe\x12\x38\xc4\xda\x48\x67\xeb\x34\xef\xa3\x1e\x6c\xd5\x94\x60\x17\x28\x69\xdf\x89\x67\x91\x52\x0b\x95\xf2\x9c\x3a\xbb\x93\x24\xa3\x6b\xb8\xad\x36\x06\x51\x29\x6f\x7b\x25\xef\xad\x7c\xa4\xbc\x20\x47\xc4\xe2\x1e\x0a\xab\x7b\x94\xb0\x01\xf9\xc7\x9c\xb0\xa5\xee\xa8\xc6\x1e\x95\x6b\x3e\xc3\x84\xe6\x9a\xd3\x58\xe2\xb3\x1d\xd6\xc7\x08\x04\x8f\x1d\xd2\x5f\xdc\xc4\x4a\xa3\x0c\x9a\x30\x2c\x4a\xad\xb3\x27\xd1\x63\x3c\x0b\xa5\xf9\xa6\xc3\xc2\x3f\xb3\x9e\x56\xd7\x4b\x3d\x6c\x68\x9e\x85\xbc\x3a\xc7\x2c\x2c\xd5\xd5\x92\x30\xb5\x34\xea\xe3\xb9\x41\xa7\xde\x88\x1b\xb8\x0a\xa0\x4a\x6f\x74\x21\xba\x36\xd5\x2e\xde\xef\xb5\xe0\x88\x09\x77\xf4\x70\x3b\x81\x67\x83\x6a\xe0\x26\x61\x17\x6f\x3b\x8d\x67\xe3\x6b\x55\xee\x73\xe7\xee\x0e\xb2\x94\x49\x85\xd2\x34\x4f\x11\x08\x9a\xdb\x52\xee\x3e\x45\xb1\x02\x91\xc5\x4a\x62\x41\x73\x05\xe4\x2c\xcb\x1d\xbd\xb3\x47\xe2\x51\x37\xed\x8e\xa7\xe0\xd9\x14\x9b\x1e\xf2\x82\x58\xbb\x71\xaa\xf3\xad\xb0\xc6\x81\x3c\x5c\xc2\x16\x19\xb2\xac\xfe\x85\x3c\xf4\x84\x97\x7e\xdf\xbf\x15\x5c\xa1\xe0\xb7\x8d\xf2\x3c\xa5\x24\xb1\xa1\xb8\x7a\xde\x68\x42\x54\xae\x9b\x52\xde\x06\xe4\xea\x60\xd3\x9f\xb4\x7c\x33\xe1\x63\x82\x1e\x3e\x00\x61\x81\xd3\x21\xba\x95\x3d\x9e\x38\x21\x78\xa1\x48\xb0\xdf\x98\xd4\x10\x1e\xf2\xa0\x85\xc6\xc0\xb3\xba\xe0\x32\x1c\x29\x62\x6b\x82\xd2\x94\x03\xce\x63\x3f\x68\xcd\x9b\x5a\xf3\xec\x39\xbb\xeb\x76\x85\xd6\xf4\x86\x3e\xe9\x09\x3a\x7d\xf8\x3b\x05\x5a\x4e\x51\x5d\x21\x6a\x7a\x43\x9f\xd4\xc2\xa1\x1e\xb3\x5b\xda\xb9\xc7\x2b\xdb\x5b\x0f\x3d\xb1\xb2\xbc\x65\xa3\x9e\x23\x85\x3b\x1b\xb4\xbc\xfe\xa7\x9e\x22\x8d\xe2\x88\xd0\x4b\x1f\x44\xb6\xeb\x3e\x00\xa3\x52\x79\x14\x94\x20\x3e\x21\xfb\x74\xce\x1e\x8b\x47\xa5\x55\x24\xcb\x53\xd4\xe3\xf3\xbc\x12\xfc\x89\x88\x4a\xc6\xf4\x21\x19\xd0\xda\xbe\x37\x46\xfa\x83\x95\xbe\xb5\xd2\x67\x4f\xdd\xed\x8c\x7d\x09\xb9\xdc\x71\x64\x68\x20\x91\x9c\x3d\x0a\x8f\xba\x59\x48\x22\x82\xeb\x65\xd0\x90\xa2\xb3\x1a\xc6\x31\x96\xe8\x76\x2d\x6a\x56\x24\x2a\xa8\xa1\xec\x52\x2d\xd6\xc5\xe8\xda\x9f\x40\x38\xfc\x53\x12\x11\x4f\x05\xbb\x98\xfd\x72\xf1\xf5\x7f\x03\x00\x7d\x29\x51\x79\x43\xe2\x00\x00"),
+		},
+		"/openshift-4.9.json": &vfsgen۰CompressedFileInfo{
+			name:             "openshift-4.9.json",
+			modTime:          time.Date(2026, 10, 17, 9, 0, 29, 788694346, time.UTC),
+			uncompressedSize: 53991,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x9d\x5d\x73\xdb\x36\xd6\xc7\xef\xfd\x29\x34\xba\x76\xfd\x24\x9e\xe7\xa2\x9b\x3b\x47\x69\x1b\xef\x26\xa9\x6b\x65\xbb\x33\xbb\xd3\x0b\x08\x3c\x96\x50\x43\x00\x0b\x80\x4e\xb2\x99\x7c\xf7\x1d\x80\x20\x25\x59\x20\x05\x92\x87\xae\xa0\x7a\x66\x67\xa7\x31\xc9\x3f\x70\x7e\x3a\x38\xc4\xcb\x01\xf8\xf5\x6c\x32\x7d\x00\xa5\x99\x14\xd3\x57\x93\xe9\xc3\xcb\xe9\xf9\xd9\x64\x4a\x79\xa1\x0d\xa8\x0f\x64\x0d\xf6\xaf\x32\x07\xa1\x57\xec\xce\x7c\xf7\xff\x17\x7f\x73\x37\x68\x50\x0f\xa0\x7e\xdd\x7e\xf0\xe2\xf2\xf2\xe2\x85\xbb\x48\x15\x10\xc3\xa4\xf8\xc8\xd6\xa0\x0d\x59\xe7\xf6\x86\xcb\x17\x97\x2f\xbf\x7b\xf9\xe2\xbb\x97\xdf\x7f\x7c\xf1\xe2\x95\xfb\xdf\xbf\xdd\xdd\x4b\x25\x8b\x5c\x4f\x5f\x4d\xfe\x73\x36\x99\x7c\x3d\x9b\x4c\x26\x53\xe1\xcb\xb5\xd7\x27\x75\xfd\xaa\x7b\xfc\x5d\x13\xff\xe8\x4e\x25\xca\x27\x26\x8f\x6d\x72\x7f\xfc\x66\xff\xff\x37\x77\xc3\x34\x57\x70\x07\x4a\x41\xb6\x79\xfa\xeb\x59\xbb\x66\x40\xd2\x2a\x7e\x3b\xdf\xab\x36\xc9\x99\x82\x25\xd3\x46\x39\x0c\x17\xf7\xdf\xeb\x0b\x26\xbb\x1a\x13\x56\xf9\xbf\x31\x6c\x3c\x54\x54\x17\xd3\x73\xdd\xdd\xd0\x5c\x8f\x64\xd6\xb6\x70\xbc\x11\xf0\x00\xc2\xe8\x9e\x3f\xdb\xce\xc3\x87\xcc\x3a\xef\x24\xb5\x00\x43\x1a\xf4\xca\x4b\x03\x58\x35\x54\x3b\x1e\x1a\x29\xcc\x0a\x84\x61\x74\xdb\x85\x3a\xbb\x42\x48\x64\x1c\xdf\x68\x2f\xa9\x9b\xdd\x52\xb1\xff\x0e\x35\xfb\xb1\xc6\x68\x56\x37\x17\xd4\xc9\x68\xa9\x29\xe1\x4c\x2c\x7b\xd8\x5a\x3d\x3a\xac\x75\xec\x08\x5d\x36\xb7\x0d\x7f\xa9\x9f\xe4\x65\xb3\xe4\xe5\xc0\x1f\x62\x9f\x42\x3c\xff\x05\x31\x74\xd5\x95\xbc\x7b\x68\x18\xf3\x4a\x62\xcc\x48\xf4\xa8\x9a\xf1\x50\x28\x28\xc3\xee\x6c\x8b\x86\x2a\x90\x75\x45\x14\x90\x38\x04\xac\x97\x8d\xad\xe5\xc4\x5b\x2c\xc0\x7c\x92\xea\x9e\x89\x65\x4f\x7b\xf7\x04\x46\xb1\xb6\xa5\x94\x78\x5b\x73\xc9\x19\xfd\xd2\xd5\xc0\xf2\xa9\x61\x4e\x5f\x6b\x8c\xe9\xf5\x8f\x2b\x1a\x0f\x46\x2d\x08\xbd\x08\x05\xf6\xae\xac\x1a\x85\x46\x71\x8a\x88\xd2\xe2\x19\x68\x23\x15\x59\x42\x4f\xc3\x77\x9f\x1e\xe6\x2c\x7b\x5a\x63\x3a\x4d\x53\xc5\xe3\xc1\x91\x6c\xcd\xb4\xbd\x35\xd0\xff\xef\x8a\xb1\x4d\x6b\x14\x17\x8a\x2b\xb0\x03\x8c\x9c\xc1\x67\x03\xc2\xde\xad\xfb\x42\x08\x68\x8c\x63\x7c\x6b\x41\xf1\x46\x6b\xba\x82\xac\xe0\x9b\xe0\xdc\xd5\xe2\x3d\x81\x51\xcc\x6d\x29\x25\xde\x56\x2a\xa5\xca\x98\xd8\xf6\x93\xae\xd6\x06\x24\x46\xb1\xb7\xb5\x9c\x78\x8b\x85\xcc\xa0\xa7\xa5\x5b\x8f\x0e\x0b\x89\xbb\x42\x63\xc6\xc3\x60\x95\xe3\x61\x65\x4c\x53\xf9\x00\xea\x4b\x4f\x62\x8f\x9f\x1f\x86\x2d\xa0\x36\x26\xbb\xe6\xca\xc7\x03\xbc\xe3\xf2\x13\x95\xc2\x28\xc9\x2f\x48\xce\xca\x49\xc2\x9e\x30\xdb\xb4\x46\x46\xd1\xa1\xe8\xa6\x92\x1b\x00\xd9\xa9\xae\x8b\x7a\x36\xb5\x07\x95\x3d\x81\x43\x3e\xd6\x0b\x40\x4b\x29\xf1\xce\xb0\xdb\xb7\x1b\x64\x74\xa3\xd2\x38\xd6\x47\x14\x17\x8f\x61\x51\x30\x9e\x0d\x32\x7f\x5f\x61\x14\xb3\xdb\x8a\x89\x37\x97\x4a\x71\xc7\x96\x83\xec\x0d\x48\x8c\x62\x70\x6b\x39\xf1\x16\xb3\xb5\xed\x82\x0f\x31\x78\x5f\x61\x14\x7b\xdb\x8a\x89\x37\x77\x4d\xe8\x8a\x89\x61\x06\x87\x34\x46\x8e\xe9\x11\x45\x36\x95\xd8\x0e\xa2\xf4\xa2\xc2\x0f\x3e\x10\xa8\x34\x0b\x8e\xe2\x15\x1d\x4a\xed\xe0\x24\x52\x30\x23\x95\x1d\x54\x50\xa9\x40\xea\x0b\x2a\xd7\x9d\x79\x84\x44\xc6\x61\xd0\x5e\x52\xbc\xdd\x7e\xa6\x6b\x90\x1b\x84\x34\x46\xb1\xba\xbd\xa0\x78\xa3\xa5\x7d\xd3\x0f\x32\x79\x5f\x61\x14\x83\xdb\x8a\xe9\x60\x6e\x0e\x8a\x18\xa9\x86\x59\x1c\x12\x19\x36\x5e\x68\x92\x24\x3c\x5f\x35\x85\x55\x7f\x6d\x08\xd3\x76\x43\xba\x63\xd5\x5b\x4d\xb0\x2f\xd5\x6d\x0d\x1c\xa8\x8f\x14\x9f\x86\x69\xd8\x8c\x78\xa4\xb9\x92\xbf\x03\x35\x83\x1c\x35\xa4\x71\x08\x69\x2f\xa3\xdb\x0b\x8a\x37\xfa\x8f\x42\x1a\x32\xc8\xe4\x7d\x85\x51\x0c\x6e\x2b\x26\xde\x5c\x25\x0b\x03\x83\xcc\xdd\x57\x18\xc5\xdc\xb6\x62\xe2\xcd\xd5\x40\x0b\xc5\xcc\x97\x41\x16\x07\x45\x46\x31\xfa\x40\x49\x1d\xec\x16\x24\xd7\x2b\x69\x2e\x76\xa7\xfc\x3b\x5b\x1e\x96\x19\x16\x23\x9b\x45\xc7\xec\xd0\x1f\x34\x25\x1e\xae\x81\x75\xce\x89\x81\x41\x4e\x15\x14\x19\xc5\xa9\x0e\x94\x14\x6f\x77\xa1\x41\x0d\xb2\x79\x4f\x60\x14\x7b\x5b\x4a\x09\x14\x62\xbd\xe9\xdb\x99\xf3\xa7\xa9\x02\x2d\x0b\x45\xa1\xb2\xe4\xeb\x59\x48\xbf\x52\xdb\xbb\x7d\x63\x78\x45\x6c\xc1\x44\xc6\xc4\xd2\xa7\xb8\x4d\x26\x53\xcd\xc4\xb2\xe0\xa4\xce\x94\xac\x2f\xd8\x27\x74\x4e\x28\x64\xd3\x57\x13\xa3\x0a\xa8\x2e\xdc\x33\x61\xff\x34\x7d\x5d\x4a\xd5\x0f\x3c\x80\x5a\x6c\x0a\x9e\xf8\x2c\x4a\x70\x36\x4d\x26\xbf\x85\x1a\x62\x55\x2b\x2a\xd7\xb9\x14\x36\x7f\xcb\x10\x53\x68\xe8\x5a\xbd\x3b\xc2\xf5\x5e\xfd\x66\x95\xe8\xdc\x89\x36\xd7\x73\x09\xa6\xba\x38\x99\x72\xa6\x4d\x5c\x95\xed\x20\x77\x4d\xf2\xae\x75\x0d\xa1\x9c\xb9\xb1\xeb\x7b\x92\x37\x57\xd2\xc3\xf4\xd7\x27\xd3\x0c\x38\xec\xff\x9b\x4a\xce\x81\xda\x11\xf0\xf4\xbc\xd9\xbc\xfa\x5f\xf9\x26\x0b\xc7\xde\x59\xe4\xd9\x4e\x19\x9f\xdc\xe5\x08\x18\x20\xb2\x5c\x32\x61\x30\x58\xfc\xb0\xa7\x95\x18\x0b\x97\x87\x88\x01\xc2\x0a\x25\x0a\x81\xb3\x35\x33\x8a\x88\x25\x60\x90\x78\x67\xd5\x6e\xad\x5a\xa2\x38\x6a\x0b\x51\x02\xdb\x87\xea\x8e\xfe\x34\x9e\xc2\x66\x99\xe1\xc4\xf1\x0f\x32\x1b\x60\xe9\x9f\xfa\xbb\xe7\xf6\x1d\xad\x0d\x08\xf3\x20\x79\xb1\x06\xca\x09\x5b\x63\xb4\x88\x9b\x5a\xf8\x57\x27\x3c\xb3\xc2\x27\x02\x09\xc5\x69\x1e\x03\x4a\x95\x8d\xcc\x50\xdc\x45\x66\xe9\x02\xa8\x46\x0b\x48\x20\x3e\x7a\xb9\x44\x81\x28\xc8\xb9\xcf\xf9\xf7\x2b\xfc\x1c\x14\x06\x9a\xdb\x8d\xf0\xac\x16\x4e\x16\x52\x39\x6c\x72\x33\x54\x38\x70\x4a\xc1\x5f\xac\x60\xa2\x50\x34\x50\x05\x28\x3d\xd3\xb9\x53\x4a\x16\x83\x7a\x60\x14\x08\xa5\xb2\xc0\xe9\xa8\xcf\x4b\xc5\xab\x52\x31\x6d\x2c\x88\x3c\xfa\x83\x40\x35\xf7\xcc\xff\xe7\xb7\xf3\xc6\xf9\x93\x03\xbb\x07\x23\xa6\x55\x7c\x92\x15\xa3\x38\xbd\x97\xab\x9b\xeb\xc1\x10\x9f\xc8\x9b\xa2\xf0\xe6\xba\x0b\xcc\xcd\x7b\x4d\xc1\x03\xb3\x93\x5c\x18\x5e\xb9\x79\xa9\xdd\x7a\xd5\xa3\x67\x1b\x6e\xa9\x19\x81\xb5\x14\x1a\x27\x94\xbf\x71\x62\x73\x30\xa9\xc2\x80\x9c\xcb\x2f\x6b\xa4\x29\x97\x37\xb5\x5a\xa2\x38\x7c\xef\x10\xc9\x39\x7c\x97\x30\x5d\xef\xd0\x86\x18\xb8\x2b\x38\x12\x8f\xb9\x97\x4b\x01\x48\x44\x5c\x0e\xef\x98\x8e\x08\xd0\x7f\xb1\x29\xce\x1e\x28\xb7\xd6\x0d\x9f\x79\xf6\xe0\xd9\xbe\xb9\x3d\x02\xa9\x91\xf7\x20\x6c\xf7\x01\x3e\xa1\xf4\xc8\x3e\x5a\xbd\x5b\xa7\x77\x90\x6f\x1f\x63\x37\xe9\xda\xdd\x6d\xe5\x92\x12\xae\x8b\x85\x4d\x95\x21\x94\x82\xd6\xfd\x0c\x0f\x79\xd4\x3b\xab\x3d\x2f\xb5\xaf\x9c\x76\x1f\x08\x0d\xd1\x19\xf8\x1d\x42\xb5\x83\xbf\xd7\x1c\xf8\xdd\xf8\xd5\x56\x05\x87\x91\x6a\x7d\x6b\xa5\x11\x2b\x3d\x16\xe7\xa1\x8c\xe3\xda\xc7\xde\x51\x03\x11\xcd\xc2\x6d\x81\x90\xc2\x10\x9e\xcb\xac\xd2\xc0\x99\x2b\x7b\x5b\x4b\xdf\xc8\xec\xaa\x96\x3e\x68\xf9\xa3\x48\xfb\xf8\xdf\x47\x12\x79\xc3\x87\x52\x3c\x13\x7f\x32\xe2\x97\xcf\xc4\x47\x25\xbe\x7b\x40\x47\x04\x66\xaa\xa4\xf8\x5d\x2e\x30\xb0\xce\x94\x14\x7f\x97\x8b\xa3\xc7\x18\x7e\x8d\x20\x41\x48\x01\x40\x07\x3f\xea\x16\x25\xff\x82\xce\x14\xc1\xb2\xed\x40\x99\x18\xa8\x5b\x8f\xb3\xa5\x60\x62\xa9\xe0\x8f\x02\x74\xe7\x61\x55\xb0\x97\x33\xdb\xa8\xcf\x4b\xf5\xdb\x52\xfd\x14\xc8\xfb\x5d\x34\x4c\x2c\x7b\x70\x77\xa0\xb5\xa6\x9c\x68\xa4\xb4\xbd\xeb\x52\x71\x66\x15\x8f\x1e\xef\x79\x1b\x14\xc0\x68\xe2\x1e\x47\xa2\x24\xbc\x6f\xb9\xb3\x81\x18\x0a\x8f\x0f\xa5\xe2\xcd\xd6\x61\x4a\x69\x37\xbf\x47\xe7\x26\x45\xb4\xb9\x5c\x66\x19\xd3\xaa\xc8\x6d\xc5\x17\x45\xb6\xc4\x99\xdd\xbc\x91\xd9\x9b\x5a\xf6\xb5\x93\x3d\x29\xbe\xdd\xde\xd2\xcf\x90\x6b\xc8\xe1\xa6\x9d\xcb\xac\xda\x1c\xd2\xb3\x79\x07\xc3\xff\x8d\xcc\xe6\x5e\xf6\x74\xda\xf8\xe1\xa3\xc2\x22\x3c\xd2\x1f\x69\xac\x24\x87\x9e\x59\xfc\x41\xe0\xb3\x52\xf6\x56\x72\x88\xcd\xe8\x7f\x44\xf8\xc9\x89\x9f\x1f\xe2\x83\x0d\x26\x51\x22\x03\x5c\x25\x14\xbb\xd2\x77\x91\x3e\xbe\xd1\x44\xe2\xe8\x11\x44\xc4\xa5\x86\x9d\x67\x31\xc1\x48\xb3\x4c\xb1\x07\x50\x38\x4d\x6d\x7e\xfd\xc6\xa9\x1d\x3d\xd4\xb0\x5f\x51\xcd\xd0\xd2\xdd\x67\xf3\xeb\x84\x33\xde\xbd\x47\x21\x8e\x07\xe7\xa5\x62\xca\xe3\xc1\x32\xf9\x9f\x18\x43\xe8\xaa\x4f\xaa\x4a\x90\x4b\x99\xd7\x7e\x55\x8b\x1e\x3d\x9b\x3e\xf1\xa8\xe3\xcc\x9a\xdd\x47\xe0\xbc\x85\x92\x9c\x50\x66\x70\x86\x9c\xb3\xf9\x75\xe5\x84\xa5\xec\x49\x8c\x3b\xa3\xce\x1e\x8d\x80\xbe\x2e\x0c\x31\x4c\x2c\x3f\xc1\x62\x25\xe5\xfd\xce\x31\x3c\x28\x8e\xfe\xde\x17\xf0\xaf\xb2\x80\xd9\x76\x01\x47\xff\x43\x34\x04\x04\xc2\x59\x36\x2e\xb5\x5f\xeb\x22\x92\xe4\x16\xe3\xc0\x6d\xe7\xc7\xc6\x44\x8b\x42\x1b\xb9\xae\xee\xcb\xe0\x8e\x09\x86\x86\x7f\xe6\xc4\xab\x6d\x03\x6f\x6a\xf1\x53\x00\xdf\x7c\x92\x6d\x04\xf5\x5c\x31\x69\xe7\x0c\x10\x7b\x08\x37\x5e\x32\x8d\x2e\x42\x04\xe0\xb6\xa3\x73\x23\x10\x73\x20\x1a\xe5\xc5\xf7\xce\x0a\x9d\x02\xd0\xd0\xb1\xba\x11\x20\x55\x21\x0c\x5b\x03\xa2\xab\xde\x96\x8a\x27\xe3\xa9\xbb\x60\xbb\xf5\xd6\x9e\xe9\x1e\xa2\xdb\x78\xa2\x71\x04\xdd\xfa\x48\x06\x8e\xb4\xab\xa7\x3a\x97\x61\xce\x4f\x64\x5b\x4a\x80\x6e\x37\x07\x7e\x46\x7c\x08\x71\xfc\x39\xd4\x11\xb8\xad\x98\xed\x7a\xac\x09\x4a\xb0\xf8\x91\xcb\x4f\x73\x27\x77\xf4\xa4\xcf\x5b\x7b\x52\x1c\x1e\x80\xe3\x0f\x20\xaa\x5e\xd5\x3b\x2b\x7f\x82\x83\x87\xa6\x03\xc2\x23\x3c\x71\xb3\xe9\xa8\xc4\x8e\xd1\xf6\x37\x5b\x8f\x4a\xd6\x27\x01\x79\x67\xc5\xab\x2f\xed\xad\x55\x0c\x05\xda\x28\x46\xfb\xf8\xf8\x81\x05\x8d\xdb\x8d\xf2\x29\x90\x6f\x39\x0a\x3d\x82\xb8\x7b\x1a\xcf\xb5\x5f\x5b\xb9\x44\xbc\x3a\x1c\x6a\x1d\x10\x34\x14\x47\x0f\x21\xc2\xc1\xda\x8e\x9e\x8f\xdf\x2f\x0d\x0a\x73\xbb\x74\xb2\xcb\x47\xbb\xbb\x9d\x70\x90\xec\x48\x26\xca\xa5\x57\xb3\x0b\xe2\x48\xa3\xdd\xb5\xe6\x35\xd4\x67\x2a\x63\xf0\xf0\xb9\x0d\x3f\x7b\xcd\xb4\xc9\xf8\x23\x34\x31\xc1\x54\x91\x2e\x51\x2e\x52\x68\xb4\x2c\x98\x52\x2b\x51\x12\x99\x40\x9a\x5a\x7a\xf3\x61\x9e\x28\x82\x3b\x20\xa6\x50\xb0\x24\x06\x87\xc4\x8f\xa5\xde\x4f\xc4\xa4\xea\x14\xee\x33\x32\x28\x2c\xae\xad\x52\xaa\x14\xc4\x9d\x22\xda\xa8\x82\xda\x9f\x13\x07\xc7\x8e\x64\xb2\x5c\xfa\x25\xef\x37\x10\x39\x81\xec\x7d\x14\x12\x3e\x6f\x3f\x51\x12\xee\xc3\x2b\x28\x1c\x7e\xbe\x2a\xcc\x2a\x55\x0a\xbe\xbb\xb8\x2a\x16\x38\x2c\xbc\xde\xdb\x62\x91\x28\x11\xff\xcd\x0f\x14\x1a\x37\xa5\x56\xba\x24\x3e\x63\x6d\x01\x50\xf2\xf3\x97\x44\x31\xf8\xdc\x08\xa4\x89\x8d\x79\xa5\x76\xf4\x34\x22\xa6\x8c\x5a\xbe\xde\x17\x31\x63\xf4\xdc\x69\x73\x9d\x36\x47\xc1\x28\x20\x6b\x8c\xe9\x48\x87\x62\xee\xe4\xd2\x07\x62\xc8\x12\x99\xc9\x47\x32\x60\xc2\xba\xb3\xf1\x5d\xdb\xd3\xe1\x0f\x42\x46\x34\x2b\x2f\xb2\x02\xc2\xcd\x8a\xae\x80\xde\x63\x30\x7c\x5f\xaa\xbe\x75\xaa\x33\xab\xda\x9f\xe3\x9f\xea\x5e\x1e\x0f\x22\x93\xc4\x41\x80\x41\x64\x71\x22\xe7\xe8\x79\x36\x3b\x4b\xf0\xbd\xdf\x73\x36\x5d\x82\x30\x01\xaa\x4a\x8f\xea\xb5\x0c\x17\x7c\xf1\xcd\x2a\xe9\x2a\x4f\x2a\xe5\x25\xb9\xcd\x21\xb1\xc8\x84\x4a\xd1\xa4\xd9\xdc\x17\x0b\xfb\xd3\x20\x82\xf9\x47\xa9\x98\x34\x95\x9d\x66\x9a\x4b\xc9\x51\xc8\xf8\x50\x56\x92\xb9\x91\x92\x9f\x02\x1d\x7c\x32\x47\x4f\x25\x26\xce\xb7\x7e\x64\x39\x22\xb6\xdb\xf3\xb3\xcc\x9a\x08\xb2\x04\x85\xf1\x1a\xbd\xda\xd2\x3b\x7a\xc0\x61\xb7\xcb\x65\xe6\xb1\x62\x00\xb9\x91\xd9\xfb\x52\x2d\x55\x1c\x4a\xae\xc1\xac\xa0\xc7\x37\xf0\x82\x3c\x6a\xb9\xe4\x79\xb8\xa3\x36\x51\x91\xd8\x13\x36\x13\xc5\xe2\xbf\x35\x80\xd8\x72\xfc\xe7\x06\x52\x69\x3d\x11\xd1\xda\x2f\x5e\xf4\xef\x88\x97\x99\x00\x5e\x06\xe5\x8d\xe8\x93\x0b\xd2\x5e\x0a\x01\xb7\xa2\x85\x7f\xb0\xd3\x0f\x4e\x37\xad\xe3\x9d\xc2\x84\x56\x52\x1b\x5d\x2c\x04\x18\x14\xaf\x79\x2b\xb5\x99\x3b\xb9\x44\x79\x08\x30\xb5\x95\x28\x44\x3e\x80\x41\xf8\x06\xe2\xf1\x84\x2a\xb7\xba\xd8\x3b\x50\xb9\xa7\x29\x67\x58\x07\x0b\xb8\x15\xca\x99\xd3\x3b\x09\xb8\x7e\x91\xb1\x37\xdf\xe7\xec\xcc\x70\x76\x26\xe5\xb2\xc8\xa8\x82\xcc\xc2\x21\x38\xa3\xe9\x99\xd5\x9c\xd5\x9a\xc9\x92\x71\xef\x79\xe4\x33\x72\xca\xce\x43\xf2\x47\xe5\xe0\x4d\x30\x24\x3d\x1b\xf5\x9c\xa3\x59\xe5\x68\x52\xcd\xb4\x20\xb9\x5e\x49\xb3\x99\xd4\xc5\xe1\x32\xbf\x9e\x7b\xe5\xcd\xcc\x6e\xa2\x94\xfe\xe2\x99\xac\x36\x93\x15\x0c\xc5\xd9\x0d\xf0\x83\xa1\x59\xda\xd9\x89\xfd\x1b\x4a\xcb\x19\xc3\xc9\xb7\x11\xbb\xee\x81\xbb\xb3\xc8\xae\x7b\xa4\xbe\xbb\xc8\x52\xd9\xf8\x4b\xcf\xe9\xe7\x46\x3a\x1b\xa7\x79\x9f\xf4\x44\xb4\xa5\x84\x9b\xbc\x65\x7d\x27\x9d\x04\xae\x16\x2a\xe5\xd9\x67\x7e\x97\xcd\x9a\x2d\xf1\xb6\x21\x39\x44\xa5\xbc\x1f\xb1\xbd\xf7\xf2\x89\xf2\xc2\x9c\x2d\x4c\x7b\x9a\xb0\x1e\x6d\xe3\x06\xe4\x9f\x73\x10\x73\x3b\x88\x4f\x3d\x2a\xd7\x7c\xc6\x09\xcd\x35\xa7\x53\x89\xcf\x7e\xc9\x83\xe2\x1c\xfe\xe1\x97\x3b\x66\x57\xa9\xd2\x28\x83\x26\x0e\x8b\x52\xeb\xe8\x49\x0c\x98\xeb\x23\x3c\x5f\x75\x48\x8a\x74\xb9\xc6\xb6\x5d\xda\x29\x55\x77\x2f\xe6\x09\xf7\x2e\xe9\xd6\x36\x4b\x10\x66\xee\xd4\x4f\xe7\xa0\xfb\x7a\x93\x72\xcf\x0c\x89\xea\x79\xa7\x8b\x31\xb4\xa9\x76\x38\xff\x64\x05\x4f\x98\x70\x47\x0f\xf7\x33\x94\x3e\xa8\xf6\xdc\x40\x1d\xe2\xed\x97\x38\x7d\x7c\xad\xea\x7d\xec\xdc\xc3\x41\x96\x09\x6d\x08\xe7\x39\x27\x28\x68\xae\x4b\xb9\x1b\x4e\x52\x05\xa2\x8b\x85\xa6\x8a\xe5\x06\xc9\x59\xe6\x5b\x7a\x47\x8f\x24\xa2\x6d\xfa\xdd\x60\xbd\x57\x9a\xfc\xf3\x98\x5f\x2e\xf3\x9b\xca\x3a\x7f\xae\xcc\x39\x50\x84\x4b\xf8\x2a\x63\xd6\x35\xbe\x92\x8f\x3d\xe1\xa9\x7f\xef\x3f\x0a\x69\x48\xef\x5f\x9b\xe4\x39\x67\x90\xf9\x50\x5c\xdd\xef\x34\x31\x1a\xd7\x55\x29\xef\x03\x72\x75\x12\xf1\x2f\x56\xbe\x99\xf0\x3e\xc1\x08\x1f\xc0\xb0\x20\xe8\x10\xdd\xea\x9e\x4e\x9c\x50\xb2\x30\xfd\x77\xc2\xb9\xa7\x31\x3c\xe4\xd6\x0a\x9d\x02\xcf\xea\x3b\x54\xfd\x91\x12\xb1\x04\xc2\xb9\x44\x5c\xe3\xbf\xb5\x9a\x57\xb5\xe6\xd1\x73\x0e\xb7\xed\x0a\xad\x1b\x0d\x7d\xb6\x0b\x74\xda\x28\xc2\x90\x52\x4d\xaa\x2f\x7d\xb9\xd1\xd0\x67\x33\x0b\xa8\xa7\xec\x96\x7e\xed\xf1\xc2\x8f\xd6\x37\x07\x9f\x46\x3b\x66\xf9\xe5\x90\x7a\x79\x14\xef\xa8\xe4\xf2\xeb\x21\xf5\xea\x68\x12\x27\x26\x9f\xc7\x20\xf2\xa3\xf6\x11\x18\x95\xca\x27\x41\x09\xe3\xed\xb1\x4b\xe7\xe8\xb1\x0c\x6a\xaf\xdd\xf6\xd4\x3e\x37\xda\xe7\x46\xfb\xdc\x68\x9f\xa8\xd1\x1a\x58\xe7\x9c\x0c\xe8\x4e\x2f\x94\xbc\x07\x55\xc9\xb8\x39\x1f\x81\x94\xa7\xfc\xda\x49\x7f\xf4\xd2\xd7\x5e\xfa\xe8\xa9\x87\x9d\x71\x28\xa1\x90\x3b\x9e\x18\x1a\x4c\x24\x47\x8f\x22\xa2\x6d\x16\x1a\x54\xef\x76\xd9\x6b\x09\x20\xd8\x0c\xd3\x98\xfb\x0f\xbb\x16\x73\xd9\xd5\x86\xe1\x04\xa4\xeb\x52\x2d\xd5\x8d\x35\xd6\x9f\x50\x38\xfc\x53\x83\x4a\xa7\x81\x9d\x4d\x7e\x3b\xfb\xf6\xbf\x01\x00\x4d\xf2\x72\xf1\xe7\xd2\x00\x00"),
+		},
+	}
+	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/kubernetes-1.19.json"].(os.FileInfo),
+		fs["/kubernetes-1.22.json"].(os.FileInfo),
+		fs["/openshift-4.6.json"].(os.FileInfo),
+		fs["/openshift-4.9.json"].(os.FileInfo),
+	}
+
+	return fs
+}()
+
+type vfsgen۰FS map[string]interface{}
+
+func (fs vfsgen۰FS) Open(path string) (http.File, error) {
+	path = pathpkg.Clean("/" + path)
+	f, ok := fs[path]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	switch f := f.(type) {
+	case *vfsgen۰CompressedFileInfo:
+		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			// This should never happen because we generate the gzip bytes such that they are always valid.
+			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
+		}
+		return &vfsgen۰CompressedFile{
+			vfsgen۰CompressedFileInfo: f,
+			gr:                        gr,
+		}, nil
+	case *vfsgen۰DirInfo:
+		return &vfsgen۰Dir{
+			vfsgen۰DirInfo: f,
+		}, nil
+	default:
+		// This should never happen because we generate only the above types.
+		panic(fmt.Sprintf("unexpected type %T", f))
+	}
+}
+
+// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
+type vfsgen۰CompressedFileInfo struct {
+	name              string
+	modTime           time.Time
+	compressedContent []byte
+	uncompressedSize  int64
+}
+
+func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
+	return f.compressedContent
+}
+
+func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
+func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
+func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰CompressedFile is an opened compressedFile instance.
+type vfsgen۰CompressedFile struct {
+	*vfsgen۰CompressedFileInfo
+	gr      *gzip.Reader
+	grPos   int64 // Actual gr uncompressed position.
+	seekPos int64 // Seek uncompressed position.
+}
+
+func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
+	if f.grPos > f.seekPos {
+		// Rewind to beginning.
+		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = 0
+	}
+	if f.grPos < f.seekPos {
+		// Fast-forward.
+		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = f.seekPos
+	}
+	n, err = f.gr.Read(p)
+	f.grPos += int64(n)
+	f.seekPos = f.grPos
+	return n, err
+}
+func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.seekPos = 0 + offset
+	case io.SeekCurrent:
+		f.seekPos += offset
+	case io.SeekEnd:
+		f.seekPos = f.uncompressedSize + offset
+	default:
+		panic(fmt.Errorf("invalid whence value: %v", whence))
+	}
+	return f.seekPos, nil
+}
+func (f *vfsgen۰CompressedFile) Close() error {
+	return f.gr.Close()
+}
+
+// vfsgen۰DirInfo is a static definition of a directory.
+type vfsgen۰DirInfo struct {
+	name    string
+	modTime time.Time
+	entries []os.FileInfo
+}
+
+func (d *vfsgen۰DirInfo) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("cannot Read from directory %s", d.name)
+}
+func (d *vfsgen۰DirInfo) Close() error               { return nil }
+func (d *vfsgen۰DirInfo) Stat() (os.FileInfo, error) { return d, nil }
+
+func (d *vfsgen۰DirInfo) Name() string       { return d.name }
+func (d *vfsgen۰DirInfo) Size() int64        { return 0 }
+func (d *vfsgen۰DirInfo) Mode() os.FileMode  { return 0755 | os.ModeDir }
+func (d *vfsgen۰DirInfo) ModTime() time.Time { return d.modTime }
+func (d *vfsgen۰DirInfo) IsDir() bool        { return true }
+func (d *vfsgen۰DirInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰Dir is an opened dir instance.
+type vfsgen۰Dir struct {
+	*vfsgen۰DirInfo
+	pos int // Position within entries for Seek and Readdir.
+}
+
+func (d *vfsgen۰Dir) Seek(offset int64, whence int) (int64, error) {
+	if offset == 0 && whence == io.SeekStart {
+		d.pos = 0
+		return 0, nil
+	}
+	return 0, fmt.Errorf("unsupported Seek in directory %s", d.name)
+}
+
+func (d *vfsgen۰Dir) Readdir(count int) ([]os.FileInfo, error) {
+	if d.pos >= len(d.entries) && count > 0 {
+		return nil, io.EOF
+	}
+	if count <= 0 || count > len(d.entries)-d.pos {
+		count = len(d.entries) - d.pos
+	}
+	e := d.entries[d.pos : d.pos+count]
+	d.pos += count
+	return e, nil
+}
@@ -0,0 +1,81 @@
+// Package profile provides reference profiles, discovery data of known Kubernetes and OpenShift versions
+// which stand in for a destination cluster which doesn't exist yet.
+//
+// Profiles are the snapshot files of the resources directory. They aren't captured from clusters, they're assembled
+// from the API reference of each release: built-in groups and those of core OpenShift operators are listed, resources
+// of optional operators aren't, and serverVersion has no build suffix. Findings against a profile are approximate,
+// a source resource missing from it is reported as source only. A profile is replaced by the "phronetic snapshot"
+// output of a freshly installed cluster of the release, then embedded with "make bundle".
+package profile
+
+//go:generate go run -tags=dev assets_generate.go
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/pkg/errors"
+)
+
+const fileExtension = ".json"
+
+// Names returns the names of available profiles, such as "openshift-4.6"
+func Names() []string {
+	dir, err := Assets.Open("/")
+	if err != nil {
+		return nil
+	}
+	defer dir.Close()
+
+	files, err := dir.Readdir(-1)
+	if err != nil {
+		return nil
+	}
+
+	names := []string{}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), fileExtension) {
+			names = append(names, strings.TrimSuffix(file.Name(), fileExtension))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Load returns the snapshot of a profile
+func Load(name string) (*api.Snapshot, error) {
+	file, err := Assets.Open("/" + name + fileExtension)
+	if os.IsNotExist(err) {
+		return nil, errors.Errorf("Unknown profile %q, available profiles: %s", name, strings.Join(Names(), ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't read profile %s", name)
+	}
+
+	snapshot, err := api.LoadSnapshot(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Can't load profile %s", name)
+	}
+	return snapshot, nil
+}
+
+// NewCluster returns the cluster standing for a profile
+func NewCluster(name string) (*api.Cluster, error) {
+	snapshot, err := Load(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := api.NewSnapshotCluster(snapshot)
+	cluster.Profile = name
+	return cluster, nil
+}
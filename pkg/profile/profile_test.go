@@ -0,0 +1,49 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, []string{"kubernetes-1.19", "kubernetes-1.22", "openshift-4.6", "openshift-4.9"}, Names())
+}
+
+func TestLoad(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			snapshot, err := Load(name)
+			require.NoError(t, err)
+			assert.Equal(t, name, snapshot.ClusterName)
+			assert.NotEmpty(t, snapshot.ServerVersion)
+			assert.NotEmpty(t, snapshot.Resources)
+
+			// Profiles are assembled by hand, each group version has its resources
+			groupVersions := []string{}
+			for _, group := range snapshot.Groups {
+				for _, version := range group.Versions {
+					groupVersions = append(groupVersions, version.GroupVersion)
+				}
+			}
+			resourceGroupVersions := []string{}
+			for _, resourceList := range snapshot.Resources {
+				resourceGroupVersions = append(resourceGroupVersions, resourceList.GroupVersion)
+			}
+			assert.Equal(t, groupVersions, resourceGroupVersions)
+		})
+	}
+
+	_, err := Load("openshift-3.0")
+	assert.EqualError(t, err, `Unknown profile "openshift-3.0", available profiles: kubernetes-1.19, kubernetes-1.22, openshift-4.6, openshift-4.9`)
+}
+
+func TestNewCluster(t *testing.T) {
+	cluster, err := NewCluster("openshift-4.6")
+	require.NoError(t, err)
+	assert.Equal(t, "openshift-4.6", cluster.Name)
+	assert.Equal(t, "openshift-4.6", cluster.Profile)
+	assert.Nil(t, cluster.Client)
+	assert.NotNil(t, cluster.Snapshot)
+}
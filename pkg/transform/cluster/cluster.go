@@ -7,35 +7,97 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+const (
+	// GapReason explains why resources without common GVK between source and destination can't be migrated
+	GapReason = "No common GVK between source and destination"
+
+	// MigOperatorSectionName is the report section name of Migration mode
+	MigOperatorSectionName = "migOperator"
+
+	// DiffSectionName is the report section name of Differential mode
+	DiffSectionName = "differential"
+)
+
 // ReportMigOperator represents json report of CAM Operator results
 type ReportMigOperator struct {
-	ClusterName string                                          `json:"clusterName,omitempty"`
-	Namespace   string                                          `json:"namespace,omitempty"`
-	Resources   []ReportResource                                `json:"unsupportedResources,omitempty"`
-	SrcOnlyRGs  map[string]map[string][]schema.GroupVersionKind `json:"sourceOnlyResources,omitempty"`
-	GapGVKs     map[string]map[string][]schema.GroupVersionKind `json:"gapGVKs,omitempty"`
+	ClusterName    string                                          `json:"clusterName,omitempty"`
+	Namespace      string                                          `json:"namespace,omitempty"`
+	Readiness      ReportReadiness                                 `json:"readiness"`
+	Resources      []ReportResource                                `json:"unsupportedResources,omitempty"`
+	SrcOnlyRGs     map[string]map[string][]schema.GroupVersionKind `json:"sourceOnlyResources,omitempty"`
+	GapGVKs        map[string]map[string][]schema.GroupVersionKind `json:"gapGVKs,omitempty"`
+	UnservedGVKs   map[string]map[string]schema.GroupVersionKind   `json:"unservedPreferredGVKs,omitempty"`
+	Relocated      []ReportRelocation                              `json:"relocatedResources,omitempty"`
+	NamespaceUsage map[string]map[string][]api.NamespaceUsage      `json:"namespaceUsage,omitempty"`
+	ClusterScoped  ReportClusterScoped                             `json:"clusterScoped,omitempty"`
+	Discovery      ReportDiscoveryErrors                           `json:"discoveryErrors,omitempty"`
+	Errors         []string                                        `json:"errors,omitempty"`
 }
 
 // ReportResource represents json data of resources
 type ReportResource struct {
-	ResourceName  string                    `json:"resourceName"`
-	NamespaceList []string                  `json:"namespaces,omitempty"`
-	Source        []schema.GroupVersionKind `json:"sourceGVKs,omitempty"`
-	Destination   []schema.GroupVersionKind `json:"destinationGVKs,omitempty"`
+	ResourceName        string                    `json:"resourceName"`
+	NamespaceList       []string                  `json:"namespaces,omitempty"`
+	Source              []schema.GroupVersionKind `json:"sourceGVKs,omitempty"`
+	Destination         []schema.GroupVersionKind `json:"destinationGVKs,omitempty"`
+	SrcPreferredVersion string                    `json:"sourcePreferredVersion,omitempty"`
+	DstPreferredVersion string                    `json:"destinationPreferredVersion,omitempty"`
+	Confidence          api.Confidence            `json:"confidence"`
+	Reason              string                    `json:"reason,omitempty"`
+	Usage               []api.NamespaceUsage      `json:"usage,omitempty"`
+}
+
+// ReportRelocation represents json data of resources whose Kind moved to another API group
+type ReportRelocation struct {
+	ResourceName string                    `json:"resourceName"`
+	Source       []schema.GroupVersionKind `json:"sourceGVKs,omitempty"`
+	Destination  []schema.GroupVersionKind `json:"destinationGVKs,omitempty"`
+	Target       schema.GroupVersionKind   `json:"targetGVK"`
+	Confidence   api.Confidence            `json:"confidence"`
 }
 
 // ReportDiff represents json report of Cluster Differential report
 type ReportDiff struct {
-	ReportSrcCluster ReportCluster `json:"sourceCluster,omitempty"`
-	ReportDstCluster ReportCluster `json:"destinationCluster,omitempty"`
+	Readiness        ReportReadiness       `json:"readiness"`
+	ReportSrcCluster ReportCluster         `json:"sourceCluster,omitempty"`
+	ReportDstCluster ReportCluster         `json:"destinationCluster,omitempty"`
+	ClusterScoped    ReportClusterScoped   `json:"clusterScoped,omitempty"`
+	Discovery        ReportDiscoveryErrors `json:"discoveryErrors,omitempty"`
+	Errors           []string              `json:"errors,omitempty"`
+	// Namespaces are the source namespaces looked into for objects of resources which can't be migrated with high confidence
+	Namespaces     []string                                   `json:"namespaces,omitempty"`
+	NamespaceUsage map[string]map[string][]api.NamespaceUsage `json:"namespaceUsage,omitempty"`
+}
+
+// ReportDiscoveryErrors represents json report of group versions which failed discovery,
+// their resources are missing from the report
+type ReportDiscoveryErrors struct {
+	Source      []api.DiscoveryError `json:"sourceCluster,omitempty"`
+	Destination []api.DiscoveryError `json:"destinationCluster,omitempty"`
 }
 
 // ReportCluster represents json report of Cluster Differential report
 type ReportCluster struct {
-	ClusterName string                                          `json:"clusterName,omitempty"`
-	GVRs        map[string]map[string][]schema.GroupVersionKind `json:"resourcesGroupVersionKinds,omitempty"`
-	SrcOnlyRGs  map[string]map[string][]schema.GroupVersionKind `json:"sourceOnlyResources,omitempty"`
-	GapGVKs     map[string]map[string][]schema.GroupVersionKind `json:"gapGroupVersionKinds,omitempty"`
+	ClusterName string `json:"clusterName,omitempty"`
+	// Profile is the reference profile the report was produced against instead of a real cluster
+	Profile           string                                          `json:"profile,omitempty"`
+	GVRs              map[string]map[string][]schema.GroupVersionKind `json:"resourcesGroupVersionKinds,omitempty"`
+	SrcOnlyRGs        map[string]map[string][]schema.GroupVersionKind `json:"sourceOnlyResources,omitempty"`
+	GapGVKs           map[string]map[string][]schema.GroupVersionKind `json:"gapGroupVersionKinds,omitempty"`
+	PreferredVersions map[string]string                               `json:"preferredVersions,omitempty"`
+	UnservedGVKs      map[string]map[string]schema.GroupVersionKind   `json:"unservedPreferredGVKs,omitempty"`
+	Relocated         []ReportRelocation                              `json:"relocatedResources,omitempty"`
+}
+
+// ReportClusterScoped represents json report of cluster-scoped resources
+type ReportClusterScoped struct {
+	SrcGVRs      map[string]map[string][]schema.GroupVersionKind `json:"sourceResourcesGroupVersionKinds,omitempty"`
+	DstGVRs      map[string]map[string][]schema.GroupVersionKind `json:"destinationResourcesGroupVersionKinds,omitempty"`
+	SrcOnlyRGs   map[string]map[string][]schema.GroupVersionKind `json:"sourceOnlyResources,omitempty"`
+	SrcGapGVKs   map[string]map[string][]schema.GroupVersionKind `json:"sourceGapGroupVersionKinds,omitempty"`
+	DstGapGVKs   map[string]map[string][]schema.GroupVersionKind `json:"destinationGapGroupVersionKinds,omitempty"`
+	UnservedGVKs map[string]map[string]schema.GroupVersionKind   `json:"unservedPreferredGVKs,omitempty"`
+	Relocated    []ReportRelocation                              `json:"relocatedResources,omitempty"`
 }
 
 // GenDiffReport inserts report values for Source Cluster for json output
@@ -43,16 +105,32 @@ func GenDiffReport(apiResources api.Resources) (clusterReport ReportDiff) {
 	logrus.Info("ClusterReport::Report:Differential")
 	clusterReport.ReportSrcCluster = GenSrcClusterReport(apiResources)
 	clusterReport.ReportDstCluster = GenDstClusterReport(apiResources)
+	clusterReport.ClusterScoped = GenClusterScopedReport(apiResources)
+	clusterReport.Discovery = GenDiscoveryErrorsReport(apiResources)
+	clusterReport.Errors = apiResources.Errors
+	clusterReport.Namespaces = apiResources.Namespaces
+	clusterReport.NamespaceUsage = apiResources.NamespaceUsage
+	clusterReport.Readiness = GenReadinessReport(apiResources.Namespaces, clusterReport.Findings())
 	return
 }
 
 // GenMigOperatorReport inserts report values for Source Cluster for json output
 func GenMigOperatorReport(apiResources api.Resources) (clusterReport ReportMigOperator) {
 	logrus.Info("ClusterReport::Report:MigOperator")
-	clusterReport.ClusterName = api.SrcClusterName
+	clusterReport.ClusterName = apiResources.SrcClusterName
 	clusterReport.Resources = GenMigResourceReport(apiResources.ResourceList)
 	clusterReport.GapGVKs = apiResources.SrcGapRGVKs
 	clusterReport.SrcOnlyRGs = apiResources.SrcOnlyRGs
+	clusterReport.UnservedGVKs = apiResources.UnservedPreferredRGVKs
+	clusterReport.Relocated = GenRelocationReport(apiResources.Relocations)
+	clusterReport.NamespaceUsage = apiResources.NamespaceUsage
+	clusterReport.ClusterScoped = GenClusterScopedReport(apiResources)
+	// Full lists of cluster-scoped resources are only relevant to differential report
+	clusterReport.ClusterScoped.SrcGVRs = nil
+	clusterReport.ClusterScoped.DstGVRs = nil
+	clusterReport.Discovery = GenDiscoveryErrorsReport(apiResources)
+	clusterReport.Errors = apiResources.Errors
+	clusterReport.Readiness = GenReadinessReport(apiResources.Namespaces, clusterReport.Findings())
 	return
 }
 
@@ -63,8 +141,13 @@ func GenMigResourceReport(apiResources []api.Resource) (ResourcesReport []Report
 		resource := ReportResource{}
 		resource.ResourceName = apiResource.ResourceName
 		resource.NamespaceList = apiResource.NamespaceList
+		resource.Usage = apiResource.Usage
 		resource.Source = apiResource.Source
 		resource.Destination = apiResource.Destination
+		resource.SrcPreferredVersion = apiResource.SrcPreferredVersion
+		resource.DstPreferredVersion = apiResource.DstPreferredVersion
+		resource.Confidence = apiResource.Confidence
+		resource.Reason = apiResource.Reason
 
 		ResourcesReport = append(ResourcesReport, resource)
 	}
@@ -72,20 +155,59 @@ func GenMigResourceReport(apiResources []api.Resource) (ResourcesReport []Report
 	return
 }
 
+// GenRelocationReport inserts report values for relocated resources for json output
+func GenRelocationReport(relocations []api.Relocation) (relocationsReport []ReportRelocation) {
+	for _, relocation := range relocations {
+		relocationsReport = append(relocationsReport, ReportRelocation{
+			ResourceName: relocation.ResourceName,
+			Source:       relocation.Source,
+			Destination:  relocation.Destination,
+			Target:       relocation.Target,
+			Confidence:   categoryConfidence[relocatedCategory],
+		})
+	}
+
+	return
+}
+
 // GenSrcClusterReport inserts report values for Source Cluster for json output
 func GenSrcClusterReport(apiResources api.Resources) (clusterReport ReportCluster) {
-	clusterReport.ClusterName = api.SrcClusterName
+	clusterReport.ClusterName = apiResources.SrcClusterName
 	clusterReport.SrcOnlyRGs = apiResources.SrcOnlyRGs
 	clusterReport.GapGVKs = apiResources.SrcGapRGVKs
 	clusterReport.GVRs = apiResources.SrcRGVKs
+	clusterReport.PreferredVersions = apiResources.SrcPreferredVersions
+	clusterReport.UnservedGVKs = apiResources.UnservedPreferredRGVKs
+	clusterReport.Relocated = GenRelocationReport(apiResources.Relocations)
 	return
 }
 
 // GenDstClusterReport inserts report values for Destination Cluster for json output
 func GenDstClusterReport(apiResources api.Resources) (clusterReport ReportCluster) {
-	clusterReport.ClusterName = api.DstClusterName
+	clusterReport.ClusterName = apiResources.DstClusterName
+	clusterReport.Profile = apiResources.DstProfile
 	// clusterReport.DstOnlyGVKs = apiResources.DstOnlyGVKs
 	clusterReport.GapGVKs = apiResources.DstGapRGVKs
 	clusterReport.GVRs = apiResources.DstRGVKs
+	clusterReport.PreferredVersions = apiResources.DstPreferredVersions
+	return
+}
+
+// GenClusterScopedReport inserts report values for cluster-scoped resources for json output
+func GenClusterScopedReport(apiResources api.Resources) (clusterScopedReport ReportClusterScoped) {
+	clusterScopedReport.SrcGVRs = apiResources.SrcClusterRGVKs
+	clusterScopedReport.DstGVRs = apiResources.DstClusterRGVKs
+	clusterScopedReport.SrcOnlyRGs = apiResources.SrcOnlyClusterRGs
+	clusterScopedReport.SrcGapGVKs = apiResources.SrcGapClusterRGVKs
+	clusterScopedReport.DstGapGVKs = apiResources.DstGapClusterRGVKs
+	clusterScopedReport.UnservedGVKs = apiResources.UnservedPreferredClusterRGVKs
+	clusterScopedReport.Relocated = GenRelocationReport(apiResources.ClusterRelocations)
+	return
+}
+
+// GenDiscoveryErrorsReport inserts report values for group versions which failed discovery for json output
+func GenDiscoveryErrorsReport(apiResources api.Resources) (discoveryReport ReportDiscoveryErrors) {
+	discoveryReport.Source = apiResources.SrcDiscoveryErrors
+	discoveryReport.Destination = apiResources.DstDiscoveryErrors
 	return
 }
@@ -0,0 +1,236 @@
+package cluster
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	migOperatorSection  = "Migration"
+	differentialSection = "Differential"
+
+	unsupportedCategory = "Unsupported resource"
+	srcOnlyCategory     = "Source only resource"
+	gapCategory         = "Gap"
+	unservedCategory    = "Unserved preferred version"
+	relocatedCategory   = "Relocated resource"
+	discoveryCategory   = "Discovery error"
+)
+
+// categoryConfidence scores each category of findings:
+//   - Unsupported resource and Gap: no common GVK between source and destination, objects can't be restored
+//   - Source only resource: API group isn't available on destination, objects can't be restored
+//   - Relocated resource: Kind is served by destination within another group, objects must be converted
+//   - Unserved preferred version: a common GVK exists but objects are backed up with the source preferred version,
+//     which destination doesn't serve, so they must be converted
+//   - Discovery error: resources of the group version are unknown, they're missing from the comparison
+//
+// Resources without any finding are migrated with HighConfidence.
+var categoryConfidence = map[string]api.Confidence{
+	unsupportedCategory: api.NoConfidence,
+	gapCategory:         api.NoConfidence,
+	srcOnlyCategory:     api.NoConfidence,
+	relocatedCategory:   api.ModerateConfidence,
+	unservedCategory:    api.ModerateConfidence,
+	discoveryCategory:   api.ModerateConfidence,
+}
+
+// Findings flattens CAM Operator report
+func (r ReportMigOperator) Findings() []finding.Finding {
+	findings := []finding.Finding{}
+	for _, resource := range r.Resources {
+		findings = append(findings, finding.Finding{
+			Category:    unsupportedCategory,
+			Scope:       finding.NamespacedScope,
+			Resource:    resource.ResourceName,
+			Group:       groupOf(resource.Source),
+			Namespaces:  resource.NamespaceList,
+			Source:      finding.GVKs(resource.Source),
+			Destination: finding.GVKs(resource.Destination),
+			Confidence:  resource.Confidence,
+			Message:     resource.Reason,
+		})
+	}
+
+	findings = append(findings, srcOnlyFindings(r.SrcOnlyRGs, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, gapFindings(r.GapGVKs, nil, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, unservedFindings(r.UnservedGVKs, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, relocatedFindings(r.Relocated, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, r.ClusterScoped.findings()...)
+	findings = append(findings, r.Discovery.findings()...)
+
+	return withSection(findings, migOperatorSection)
+}
+
+// Findings flattens Cluster Differential report
+func (r ReportDiff) Findings() []finding.Finding {
+	findings := []finding.Finding{}
+	findings = append(findings, srcOnlyFindings(r.ReportSrcCluster.SrcOnlyRGs, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, gapFindings(r.ReportSrcCluster.GapGVKs, r.ReportDstCluster.GapGVKs, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, unservedFindings(r.ReportSrcCluster.UnservedGVKs, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, relocatedFindings(r.ReportSrcCluster.Relocated, r.NamespaceUsage, finding.NamespacedScope)...)
+	findings = append(findings, r.ClusterScoped.findings()...)
+	findings = append(findings, r.Discovery.findings()...)
+
+	return withSection(findings, differentialSection)
+}
+
+func (r ReportClusterScoped) findings() []finding.Finding {
+	findings := []finding.Finding{}
+	findings = append(findings, srcOnlyFindings(r.SrcOnlyRGs, nil, finding.ClusterScope)...)
+	findings = append(findings, gapFindings(r.SrcGapGVKs, r.DstGapGVKs, nil, finding.ClusterScope)...)
+	findings = append(findings, unservedFindings(r.UnservedGVKs, nil, finding.ClusterScope)...)
+	findings = append(findings, relocatedFindings(r.Relocated, nil, finding.ClusterScope)...)
+	return findings
+}
+
+func (r ReportDiscoveryErrors) findings() []finding.Finding {
+	findings := []finding.Finding{}
+	for _, discoveryError := range r.Source {
+		findings = append(findings, discoveryFinding(discoveryError, "source"))
+	}
+	for _, discoveryError := range r.Destination {
+		findings = append(findings, discoveryFinding(discoveryError, "destination"))
+	}
+	return findings
+}
+
+func discoveryFinding(discoveryError api.DiscoveryError, cluster string) finding.Finding {
+	gv, _ := schema.ParseGroupVersion(discoveryError.GroupVersion)
+	return finding.Finding{
+		Category:   discoveryCategory,
+		Group:      api.GroupKey(gv.Group),
+		Source:     discoveryError.GroupVersion,
+		Confidence: categoryConfidence[discoveryCategory],
+		Message:    fmt.Sprintf("Discovery of %s failed on %s: %s", discoveryError.GroupVersion, cluster, discoveryError.Message),
+	}
+}
+
+func srcOnlyFindings(srcOnly map[string]map[string][]schema.GroupVersionKind, usage map[string]map[string][]api.NamespaceUsage, scope string) []finding.Finding {
+	findings := []finding.Finding{}
+	forEachRG(srcOnly, func(resource, group string, gvks []schema.GroupVersionKind) {
+		findings = append(findings, finding.Finding{
+			Category:   srcOnlyCategory,
+			Scope:      scope,
+			Resource:   resource,
+			Group:      group,
+			Namespaces: api.UsageNamespaces(usage[resource][group]),
+			Source:     finding.GVKs(gvks),
+			Confidence: categoryConfidence[srcOnlyCategory],
+			Message:    fmt.Sprintf("API group %s is not available on destination", group),
+		})
+	})
+	return findings
+}
+
+func gapFindings(srcGap, dstGap map[string]map[string][]schema.GroupVersionKind, usage map[string]map[string][]api.NamespaceUsage, scope string) []finding.Finding {
+	findings := []finding.Finding{}
+	forEachRG(srcGap, func(resource, group string, gvks []schema.GroupVersionKind) {
+		findings = append(findings, finding.Finding{
+			Category:    gapCategory,
+			Scope:       scope,
+			Resource:    resource,
+			Group:       group,
+			Namespaces:  api.UsageNamespaces(usage[resource][group]),
+			Source:      finding.GVKs(gvks),
+			Destination: finding.GVKs(dstGap[resource][group]),
+			Confidence:  categoryConfidence[gapCategory],
+			Message:     GapReason,
+		})
+	})
+	return findings
+}
+
+func unservedFindings(unserved map[string]map[string]schema.GroupVersionKind, usage map[string]map[string][]api.NamespaceUsage, scope string) []finding.Finding {
+	rgs := []resourceGroup{}
+	for resource, groups := range unserved {
+		for group := range groups {
+			rgs = append(rgs, resourceGroup{resource: resource, group: group})
+		}
+	}
+
+	findings := []finding.Finding{}
+	for _, rg := range sortResourceGroups(rgs) {
+		gvk := unserved[rg.resource][rg.group]
+		findings = append(findings, finding.Finding{
+			Category:   unservedCategory,
+			Scope:      scope,
+			Resource:   rg.resource,
+			Group:      rg.group,
+			Namespaces: api.UsageNamespaces(usage[rg.resource][rg.group]),
+			Source:     finding.GVK(gvk),
+			Confidence: categoryConfidence[unservedCategory],
+			Message:    fmt.Sprintf("Source preferred version %s is not served by destination", gvk.Version),
+		})
+	}
+	return findings
+}
+
+func relocatedFindings(relocations []ReportRelocation, usage map[string]map[string][]api.NamespaceUsage, scope string) []finding.Finding {
+	findings := []finding.Finding{}
+	for _, relocation := range relocations {
+		group := groupOf(relocation.Source)
+		findings = append(findings, finding.Finding{
+			Category:    relocatedCategory,
+			Scope:       scope,
+			Resource:    relocation.ResourceName,
+			Group:       group,
+			Namespaces:  api.UsageNamespaces(usage[relocation.ResourceName][group]),
+			Source:      finding.GVKs(relocation.Source),
+			Destination: finding.GVKs(relocation.Destination),
+			Confidence:  relocation.Confidence,
+			Message:     fmt.Sprintf("Objects can be restored as %s", finding.GVK(relocation.Target)),
+		})
+	}
+	return findings
+}
+
+// resourceGroup is a resource name and group pair
+type resourceGroup struct {
+	resource string
+	group    string
+}
+
+// sortResourceGroups sorts pairs by resource name then group name
+func sortResourceGroups(rgs []resourceGroup) []resourceGroup {
+	sort.Slice(rgs, func(i, j int) bool {
+		if rgs[i].resource != rgs[j].resource {
+			return rgs[i].resource < rgs[j].resource
+		}
+		return rgs[i].group < rgs[j].group
+	})
+	return rgs
+}
+
+// forEachRG calls fn with GVKs of each resource and group, sorted by resource then group
+func forEachRG(rgvks map[string]map[string][]schema.GroupVersionKind, fn func(resource, group string, gvks []schema.GroupVersionKind)) {
+	rgs := []resourceGroup{}
+	for resource, groups := range rgvks {
+		for group := range groups {
+			rgs = append(rgs, resourceGroup{resource: resource, group: group})
+		}
+	}
+
+	for _, rg := range sortResourceGroups(rgs) {
+		fn(rg.resource, rg.group, rgvks[rg.resource][rg.group])
+	}
+}
+
+func groupOf(gvks []schema.GroupVersionKind) string {
+	if len(gvks) == 0 {
+		return ""
+	}
+	return api.GroupKey(gvks[0].Group)
+}
+
+func withSection(findings []finding.Finding, section string) []finding.Finding {
+	for i := range findings {
+		findings[i].Section = section
+	}
+	return findings
+}
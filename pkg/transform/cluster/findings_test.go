@@ -0,0 +1,22 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestForEachRG(t *testing.T) {
+	rgvks := map[string]map[string][]schema.GroupVersionKind{
+		"ingresses":   {"networking.k8s.io": nil, "extensions": nil},
+		"deployments": {"apps": nil},
+	}
+
+	visited := []string{}
+	forEachRG(rgvks, func(resource, group string, gvks []schema.GroupVersionKind) {
+		visited = append(visited, resource+"."+group)
+	})
+	assert.Equal(t, []string{"deployments.apps", "ingresses.extensions", "ingresses.networking.k8s.io"}, visited)
+}
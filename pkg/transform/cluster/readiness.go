@@ -0,0 +1,98 @@
+package cluster
+
+import (
+	"sort"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+)
+
+// ReportReadiness represents json report of migration readiness
+type ReportReadiness struct {
+	Confidence      api.Confidence             `json:"confidence"`
+	FindingCounts   map[string]int             `json:"findingCounts,omitempty"`
+	NamespaceCounts map[string]int             `json:"namespaceCounts,omitempty"`
+	Namespaces      []ReportNamespaceReadiness `json:"namespaces,omitempty"`
+}
+
+// ReportNamespaceReadiness represents json report of a namespace migration readiness
+type ReportNamespaceReadiness struct {
+	Name       string         `json:"name"`
+	Confidence api.Confidence `json:"confidence"`
+	Resources  []string       `json:"resources,omitempty"`
+}
+
+// GenReadinessReport scores migration readiness from findings.
+// Each namespace gets the lowest confidence of findings about resources having objects in the namespace,
+// and lists those resources. Namespaces are sorted from the least to the most confident.
+// Overall confidence is the lowest confidence of namespaces and of findings which aren't about namespaced resources,
+// such as cluster-scoped resources and discovery errors, or of all findings when there are no namespaces.
+func GenReadinessReport(namespaces []string, findings []finding.Finding) (readiness ReportReadiness) {
+	readiness.FindingCounts = map[string]int{}
+	confidences := []api.Confidence{}
+	clusterConfidences := []api.Confidence{}
+	for _, f := range findings {
+		readiness.FindingCounts[f.Confidence.String()]++
+		confidences = append(confidences, f.Confidence)
+		if f.Scope != finding.NamespacedScope {
+			clusterConfidences = append(clusterConfidences, f.Confidence)
+		}
+	}
+
+	if len(namespaces) == 0 {
+		readiness.Confidence = api.LowestConfidence(confidences...)
+		return
+	}
+
+	readiness.NamespaceCounts = map[string]int{}
+	confidences = clusterConfidences
+	for _, namespace := range namespaces {
+		namespaceReadiness := ReportNamespaceReadiness{
+			Name:       namespace,
+			Confidence: api.HighConfidence,
+		}
+		resources := map[string]bool{}
+		for _, f := range findings {
+			if !contains(f.Namespaces, namespace) {
+				continue
+			}
+			namespaceReadiness.Confidence = api.LowestConfidence(namespaceReadiness.Confidence, f.Confidence)
+			resources[qualifiedResource(f.Resource, f.Group)] = true
+		}
+		for resource := range resources {
+			namespaceReadiness.Resources = append(namespaceReadiness.Resources, resource)
+		}
+		sort.Strings(namespaceReadiness.Resources)
+
+		readiness.Namespaces = append(readiness.Namespaces, namespaceReadiness)
+		readiness.NamespaceCounts[namespaceReadiness.Confidence.String()]++
+		confidences = append(confidences, namespaceReadiness.Confidence)
+	}
+
+	sort.SliceStable(readiness.Namespaces, func(i, j int) bool {
+		if readiness.Namespaces[i].Confidence != readiness.Namespaces[j].Confidence {
+			return readiness.Namespaces[i].Confidence < readiness.Namespaces[j].Confidence
+		}
+		return readiness.Namespaces[i].Name < readiness.Namespaces[j].Name
+	})
+
+	readiness.Confidence = api.LowestConfidence(confidences...)
+	return
+}
+
+// qualifiedResource returns resource name qualified with its group, such as cronjobs.batch
+func qualifiedResource(resource, group string) string {
+	if group == "" || group == api.CoreGroup {
+		return resource
+	}
+	return resource + "." + group
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenReadinessReport(t *testing.T) {
+	findings := []finding.Finding{
+		{Scope: finding.NamespacedScope, Resource: "cronjobs", Group: "batch", Namespaces: []string{"app1", "app2"}, Confidence: api.NoConfidence},
+		{Scope: finding.NamespacedScope, Resource: "ingresses", Group: "extensions", Namespaces: []string{"app2", "app3"}, Confidence: api.ModerateConfidence},
+		{Scope: finding.NamespacedScope, Resource: "routes", Group: "route.openshift.io", Confidence: api.NoConfidence},
+		{Scope: finding.ClusterScope, Resource: "podsecuritypolicies", Group: "extensions", Confidence: api.ModerateConfidence},
+	}
+
+	testCases := []struct {
+		name       string
+		namespaces []string
+		expected   ReportReadiness
+	}{
+		{
+			name: "without namespaces",
+			expected: ReportReadiness{
+				Confidence:    api.NoConfidence,
+				FindingCounts: map[string]int{"None": 2, "Moderate": 2},
+			},
+		},
+		{
+			name:       "namespaces sorted by confidence",
+			namespaces: []string{"app4", "app3", "app2", "app1"},
+			expected: ReportReadiness{
+				Confidence:      api.NoConfidence,
+				FindingCounts:   map[string]int{"None": 2, "Moderate": 2},
+				NamespaceCounts: map[string]int{"None": 2, "Moderate": 1, "High": 1},
+				Namespaces: []ReportNamespaceReadiness{
+					{Name: "app1", Confidence: api.NoConfidence, Resources: []string{"cronjobs.batch"}},
+					{Name: "app2", Confidence: api.NoConfidence, Resources: []string{"cronjobs.batch", "ingresses.extensions"}},
+					{Name: "app3", Confidence: api.ModerateConfidence, Resources: []string{"ingresses.extensions"}},
+					{Name: "app4", Confidence: api.HighConfidence},
+				},
+			},
+		},
+		{
+			name:       "namespaces without findings, cluster-scoped findings",
+			namespaces: []string{"app4"},
+			expected: ReportReadiness{
+				Confidence:      api.ModerateConfidence,
+				FindingCounts:   map[string]int{"None": 2, "Moderate": 2},
+				NamespaceCounts: map[string]int{"High": 1},
+				Namespaces: []ReportNamespaceReadiness{
+					{Name: "app4", Confidence: api.HighConfidence},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GenReadinessReport(tc.namespaces, findings))
+		})
+	}
+}
@@ -1,17 +1,18 @@
 package transform
 
 import (
+	"context"
+	"sort"
 	"strings"
 
 	"github.com/gildub/phronetic/pkg/api"
-	"github.com/gildub/phronetic/pkg/env"
 	"github.com/gildub/phronetic/pkg/transform/cluster"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
 	"github.com/sirupsen/logrus"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/kubernetes"
 )
 
 // ClusterTransformName is the cluster report name
@@ -20,97 +21,368 @@ const ClusterTransformName = "Cluster"
 // ClusterExtraction holds data extracted from k8s API resources
 type ClusterExtraction struct {
 	api.Resources
+	Mode string
+	// source is the source cluster, used to look for resources usage in namespaces
+	source *api.Cluster
 }
 
 // ClusterTransform reprents transform for k8s API resources
 type ClusterTransform struct {
+	Session *api.Session
 }
 
 // Transform converts the retrieved information to a useful output
-func (e ClusterExtraction) Transform() ([]Output, error) {
-	outputs := []Output{}
+func (e ClusterExtraction) Transform() ([]reportoutput.Section, error) {
 	logrus.Info("ClusterTransform::Transform:Reports")
 
-	if env.Config().GetString("Mode") == "Differential" {
-		diffReport := cluster.GenDiffReport(e.Resources)
-		FinalReportOutput.Report.DiffReport = diffReport
-	} else {
-		migOperatorReport := cluster.GenMigOperatorReport(e.Resources)
-		FinalReportOutput.Report.MigOperatorReport = migOperatorReport
+	if e.Mode == "Differential" {
+		return []reportoutput.Section{
+			{Name: cluster.DiffSectionName, Content: cluster.GenDiffReport(e.Resources)},
+		}, nil
 	}
 
-	return outputs, nil
+	return []reportoutput.Section{
+		{Name: cluster.MigOperatorSectionName, Content: cluster.GenMigOperatorReport(e.Resources)},
+	}, nil
 }
 
 // Validate no need to validate it, data is exctracted from API
 func (e ClusterExtraction) Validate() (err error) { return }
 
 // Extract collects data for cluster report
-func (e ClusterTransform) Extract() (Extraction, error) {
-	extraction := &ClusterExtraction{}
+func (e ClusterTransform) Extract(ctx context.Context) (Extraction, error) {
+	extraction := &ClusterExtraction{
+		Mode:   e.Session.Mode,
+		source: e.Session.Source,
+	}
+	extraction.SrcClusterName = e.Session.Source.Name
+	extraction.DstClusterName = e.Session.Destination.Name
+	extraction.DstProfile = e.Session.Destination.Profile
 
-	extraction.SrcOnlyRGs = map[string]map[string][]schema.GroupVersionKind{}
-	extraction.SrcGapRGVKs = map[string]map[string][]schema.GroupVersionKind{}
-	extraction.DstGapRGVKs = map[string]map[string][]schema.GroupVersionKind{}
+	srcSnapshot, err := e.Session.Source.Discover()
+	if err != nil {
+		return nil, err
+	}
 
-	api.SrcRESTMapper = api.RESTMapperGetGRs(api.K8sSrcClient)
-	api.DstRESTMapper = api.RESTMapperGetGRs(api.K8sDstClient)
+	dstSnapshot, err := e.Session.Destination.Discover()
+	if err != nil {
+		return nil, err
+	}
+	srcRESTMapper := e.Session.Source.RESTMapper
+	dstRESTMapper := e.Session.Destination.RESTMapper
 
-	extraction.SrcRGVKs = listNamespacedResources(api.K8sSrcClient, api.SrcRESTMapper)
-	extraction.DstRGVKs = listNamespacedResources(api.K8sDstClient, api.DstRESTMapper)
+	// Resources of group versions which failed discovery are missing from the comparison
+	extraction.SrcDiscoveryErrors = srcSnapshot.DiscoveryErrors
+	extraction.DstDiscoveryErrors = dstSnapshot.DiscoveryErrors
 
-	for srcRes, srcGroupGVKs := range extraction.SrcRGVKs {
-		for srcGroup, srcGVKs := range srcGroupGVKs {
-			if dstGVKs, ok := extraction.DstRGVKs[srcRes][srcGroup]; ok {
-				if !hasSameGVKs(srcGVKs, dstGVKs) {
-					if !hasCommonGVKs(srcGVKs, dstGVKs) {
-						if env.Config().GetString("Mode") == "Migration" {
-							resource := api.Resource{
-								ResourceName: srcRes,
-								Source:       srcGVKs,
-								Destination:  dstGVKs,
-								Support:      "false",
-							}
-
-							curGVR := schema.GroupVersionResource{
-								Group: srcGroup,
-								// TODO: Replace with Preferred Version
-								Version:  extraction.SrcRGVKs[srcRes][srcGroup][0].Version,
-								Resource: srcRes,
-							}
-							crdClient := api.K8sSrcDynClient.Resource(curGVR)
-
-							for _, namespaceName := range api.MigPlan.Spec.Namespaces {
-								namespace := api.GetNamespace(api.K8sSrcClient, namespaceName)
-
-								crd, err := crdClient.Namespace(namespace.Name).List(metav1.ListOptions{})
-								if err != nil {
-									logrus.Fatalf("Error getting CRD %v", err)
-								}
-
-								if crd != nil {
-									resource.NamespaceList = append(resource.NamespaceList, namespace.Name)
-								}
-							}
-							extraction.ResourceList = append(extraction.ResourceList, resource)
-						} else {
-							extraction.SrcGapRGVKs[srcRes] = map[string][]schema.GroupVersionKind{}
-							extraction.SrcGapRGVKs[srcRes][srcGroup] = srcGVKs
-							extraction.DstGapRGVKs[srcRes] = map[string][]schema.GroupVersionKind{}
-							extraction.DstGapRGVKs[srcRes][srcGroup] = dstGVKs
-						}
-					}
+	extraction.SrcPreferredVersions = srcSnapshot.PreferredVersions()
+	extraction.DstPreferredVersions = dstSnapshot.PreferredVersions()
+
+	srcServerResources := srcSnapshot.Resources
+	dstServerResources := dstSnapshot.Resources
+
+	extraction.SrcRGVKs = extraction.listResources(srcServerResources, srcRESTMapper, true)
+	extraction.DstRGVKs = extraction.listResources(dstServerResources, dstRESTMapper, true)
+
+	// Source GVKs of group versions destination failed to discover aren't known to be missing,
+	// they're left out of the comparison and reported with the discovery errors
+	dstUndiscovered := undiscoveredGroupVersions(dstSnapshot.DiscoveryErrors)
+	srcRGVKs := withoutGroupVersions(extraction.SrcRGVKs, dstUndiscovered)
+
+	srcOnlyRGs, srcGapRGVKs, dstGapRGVKs := compareRGVKs(srcRGVKs, extraction.DstRGVKs)
+	extraction.SrcOnlyRGs = srcOnlyRGs
+	extraction.Relocations = relocateRGs(extraction.SrcOnlyRGs, extraction.DstRGVKs, extraction.DstPreferredVersions)
+	extraction.UnservedPreferredRGVKs = unservedPreferredRGVKs(srcRGVKs, extraction.DstRGVKs, extraction.SrcPreferredVersions)
+
+	if extraction.Mode == "Migration" {
+		extraction.SrcGapRGVKs = map[string]map[string][]schema.GroupVersionKind{}
+		extraction.DstGapRGVKs = map[string]map[string][]schema.GroupVersionKind{}
+		extraction.Namespaces = extraction.usageLister().getNamespaces(e.Session.MigPlan.GetSourceNamespaces())
+		extraction.NamespaceUsage = map[string]map[string][]api.NamespaceUsage{}
+
+		for srcRes, srcGroupGVKs := range srcGapRGVKs {
+			for srcGroup, srcGVKs := range srcGroupGVKs {
+				gvk := preferredGVK(srcGVKs, extraction.SrcPreferredVersions[srcGroup])
+				resource := api.Resource{
+					ResourceName:        srcRes,
+					Source:              srcGVKs,
+					Destination:         dstGapRGVKs[srcRes][srcGroup],
+					SrcPreferredVersion: gvk.Version,
+					DstPreferredVersion: preferredGVK(dstGapRGVKs[srcRes][srcGroup], extraction.DstPreferredVersions[srcGroup]).Version,
+					Confidence:          api.NoConfidence,
+					Reason:              cluster.GapReason,
+				}
+				resource.Usage = extraction.usageOf(ctx, srcRes, gvk)
+				resource.NamespaceList = api.UsageNamespaces(resource.Usage)
+				setNamespaceUsage(extraction.NamespaceUsage, srcRes, srcGroup, resource.Usage)
+				extraction.ResourceList = append(extraction.ResourceList, resource)
+			}
+		}
+
+		extraction.setOtherUsage(ctx)
+	} else {
+		extraction.SrcGapRGVKs = srcGapRGVKs
+		extraction.DstGapRGVKs = dstGapRGVKs
+
+		// Namespace usage is only looked for in the selected namespaces of a live source cluster
+		if scope := e.Session.NamespaceScope; !scope.IsEmpty() {
+			extraction.Namespaces = extraction.usageLister().scopeNamespaces(scope)
+			extraction.NamespaceUsage = map[string]map[string][]api.NamespaceUsage{}
+
+			for srcRes, srcGroupGVKs := range srcGapRGVKs {
+				for srcGroup, srcGVKs := range srcGroupGVKs {
+					gvk := preferredGVK(srcGVKs, extraction.SrcPreferredVersions[srcGroup])
+					setNamespaceUsage(extraction.NamespaceUsage, srcRes, srcGroup, extraction.usageOf(ctx, srcRes, gvk))
 				}
-			} else {
-				extraction.SrcOnlyRGs[srcRes] = map[string][]schema.GroupVersionKind{}
-				extraction.SrcOnlyRGs[srcRes][srcGroup] = srcGVKs
 			}
+			extraction.setOtherUsage(ctx)
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Cluster-scoped resources have no namespace to look into, gaps are reported in both modes
+	extraction.SrcClusterRGVKs = extraction.listResources(srcServerResources, srcRESTMapper, false)
+	extraction.DstClusterRGVKs = extraction.listResources(dstServerResources, dstRESTMapper, false)
+	srcClusterRGVKs := withoutGroupVersions(extraction.SrcClusterRGVKs, dstUndiscovered)
+	extraction.SrcOnlyClusterRGs, extraction.SrcGapClusterRGVKs, extraction.DstGapClusterRGVKs = compareRGVKs(srcClusterRGVKs, extraction.DstClusterRGVKs)
+	extraction.ClusterRelocations = relocateRGs(extraction.SrcOnlyClusterRGs, extraction.DstClusterRGVKs, extraction.DstPreferredVersions)
+	extraction.UnservedPreferredClusterRGVKs = unservedPreferredRGVKs(srcClusterRGVKs, extraction.DstClusterRGVKs, extraction.SrcPreferredVersions)
+
 	return *extraction, nil
 }
 
+// usageLister returns the lister of objects of source cluster, recording errors in the extraction
+func (e *ClusterExtraction) usageLister() usageLister {
+	return usageLister{source: e.source, addError: e.AddError}
+}
+
+// setOtherUsage looks for namespace usage of source only, relocated and unserved resources,
+// which can't be migrated with high confidence either
+func (e *ClusterExtraction) setOtherUsage(ctx context.Context) {
+	for srcRes, srcGroupGVKs := range e.SrcOnlyRGs {
+		for srcGroup, srcGVKs := range srcGroupGVKs {
+			gvk := preferredGVK(srcGVKs, e.SrcPreferredVersions[srcGroup])
+			setNamespaceUsage(e.NamespaceUsage, srcRes, srcGroup, e.usageOf(ctx, srcRes, gvk))
+		}
+	}
+
+	for _, relocation := range e.Relocations {
+		srcGroup := api.GroupKey(relocation.Source[0].Group)
+		gvk := preferredGVK(relocation.Source, e.SrcPreferredVersions[srcGroup])
+		setNamespaceUsage(e.NamespaceUsage, relocation.ResourceName, srcGroup, e.usageOf(ctx, relocation.ResourceName, gvk))
+	}
+
+	for srcRes, srcGroupGVK := range e.UnservedPreferredRGVKs {
+		for srcGroup, gvk := range srcGroupGVK {
+			setNamespaceUsage(e.NamespaceUsage, srcRes, srcGroup, e.usageOf(ctx, srcRes, gvk))
+		}
+	}
+}
+
+// usageOf returns the objects of the resource on source cluster in each namespace looked into having some
+func (e *ClusterExtraction) usageOf(ctx context.Context, resource string, gvk schema.GroupVersionKind) []api.NamespaceUsage {
+	return e.usageLister().usageOf(ctx, e.Namespaces, resource, gvk)
+}
+
+func setNamespaceUsage(usage map[string]map[string][]api.NamespaceUsage, resource, group string, namespaceUsage []api.NamespaceUsage) {
+	if len(namespaceUsage) == 0 {
+		return
+	}
+	if _, ok := usage[resource]; !ok {
+		usage[resource] = map[string][]api.NamespaceUsage{}
+	}
+	usage[resource][group] = namespaceUsage
+}
+
+// undiscoveredGroupVersions returns the group versions of discovery errors
+func undiscoveredGroupVersions(discoveryErrors []api.DiscoveryError) map[schema.GroupVersion]bool {
+	gvs := map[schema.GroupVersion]bool{}
+	for _, discoveryError := range discoveryErrors {
+		gv, err := schema.ParseGroupVersion(discoveryError.GroupVersion)
+		if err != nil {
+			continue
+		}
+		gvs[gv] = true
+	}
+	return gvs
+}
+
+// withoutGroupVersions returns RGVKs without the GVKs of gvs, resources groups left without GVK are dropped
+func withoutGroupVersions(rgvks map[string]map[string][]schema.GroupVersionKind, gvs map[schema.GroupVersion]bool) map[string]map[string][]schema.GroupVersionKind {
+	if len(gvs) == 0 {
+		return rgvks
+	}
+
+	kept := map[string]map[string][]schema.GroupVersionKind{}
+	for resource, groupGVKs := range rgvks {
+		for group, gvks := range groupGVKs {
+			keptGVKs := []schema.GroupVersionKind{}
+			for _, gvk := range gvks {
+				if !gvs[gvk.GroupVersion()] {
+					keptGVKs = append(keptGVKs, gvk)
+				}
+			}
+			if len(keptGVKs) == 0 {
+				continue
+			}
+
+			if _, ok := kept[resource]; !ok {
+				kept[resource] = map[string][]schema.GroupVersionKind{}
+			}
+			kept[resource][group] = keptGVKs
+		}
+	}
+	return kept
+}
+
+// compareRGVKs breaks down source RGVKs against destination RGVKs and returns
+// the resources whose group is only available on source (srcOnly)
+// and the resources whose group is on both sides without any common GVK (srcGap and dstGap)
+func compareRGVKs(src, dst map[string]map[string][]schema.GroupVersionKind) (srcOnly, srcGap, dstGap map[string]map[string][]schema.GroupVersionKind) {
+	srcOnly = map[string]map[string][]schema.GroupVersionKind{}
+	srcGap = map[string]map[string][]schema.GroupVersionKind{}
+	dstGap = map[string]map[string][]schema.GroupVersionKind{}
+
+	for srcRes, srcGroupGVKs := range src {
+		for srcGroup, srcGVKs := range srcGroupGVKs {
+			dstGVKs, ok := dst[srcRes][srcGroup]
+			if !ok {
+				if _, ok := srcOnly[srcRes]; !ok {
+					srcOnly[srcRes] = map[string][]schema.GroupVersionKind{}
+				}
+				srcOnly[srcRes][srcGroup] = srcGVKs
+				continue
+			}
+
+			if hasSameGVKs(srcGVKs, dstGVKs) || hasCommonGVKs(srcGVKs, dstGVKs) {
+				continue
+			}
+
+			if _, ok := srcGap[srcRes]; !ok {
+				srcGap[srcRes] = map[string][]schema.GroupVersionKind{}
+				dstGap[srcRes] = map[string][]schema.GroupVersionKind{}
+			}
+			srcGap[srcRes][srcGroup] = srcGVKs
+			dstGap[srcRes][srcGroup] = dstGVKs
+		}
+	}
+	return
+}
+
+// relocateRGs looks for resources only available on source whose Kind is served by destination within another group,
+// such as extensions Ingress moved to networking.k8s.io. Matching resources are removed from srcOnly
+// and returned as relocations targeting the destination preferred version of the first matching group.
+func relocateRGs(srcOnly, dst map[string]map[string][]schema.GroupVersionKind, dstPreferredVersions map[string]string) []api.Relocation {
+	relocations := []api.Relocation{}
+
+	resources := make([]string, 0, len(srcOnly))
+	for srcRes := range srcOnly {
+		resources = append(resources, srcRes)
+	}
+	sort.Strings(resources)
+
+	for _, srcRes := range resources {
+		groups := make([]string, 0, len(srcOnly[srcRes]))
+		for srcGroup := range srcOnly[srcRes] {
+			groups = append(groups, srcGroup)
+		}
+		sort.Strings(groups)
+
+		for _, srcGroup := range groups {
+			srcGVKs := srcOnly[srcRes][srcGroup]
+			relocation := api.Relocation{
+				ResourceName: srcRes,
+				Source:       srcGVKs,
+			}
+
+			dstGroups := make([]string, 0, len(dst[srcRes]))
+			for dstGroup := range dst[srcRes] {
+				dstGroups = append(dstGroups, dstGroup)
+			}
+			sort.Strings(dstGroups)
+
+			for _, dstGroup := range dstGroups {
+				if dstGroup == srcGroup {
+					continue
+				}
+				dstGVKs := getKindsFrom(dst[srcRes][dstGroup], srcGVKs[0].Kind)
+				if len(dstGVKs) == 0 {
+					continue
+				}
+				if len(relocation.Destination) == 0 {
+					relocation.Target = preferredGVK(dstGVKs, dstPreferredVersions[dstGroup])
+				}
+				relocation.Destination = append(relocation.Destination, dstGVKs...)
+			}
+
+			if len(relocation.Destination) == 0 {
+				continue
+			}
+
+			relocations = append(relocations, relocation)
+			delete(srcOnly[srcRes], srcGroup)
+			if len(srcOnly[srcRes]) == 0 {
+				delete(srcOnly, srcRes)
+			}
+		}
+	}
+	return relocations
+}
+
+func getKindsFrom(GVKs []schema.GroupVersionKind, kind string) []schema.GroupVersionKind {
+	list := []schema.GroupVersionKind{}
+	for _, GVK := range GVKs {
+		if GVK.Kind == kind {
+			list = append(list, GVK)
+		}
+	}
+	return list
+}
+
+// unservedPreferredRGVKs returns the source preferred GVK of resources sharing a GVK with destination
+// but whose preferred one destination doesn't serve. This is the version Velero backs up objects with.
+// Resources without any common GVK are gaps, they're left out.
+func unservedPreferredRGVKs(src, dst map[string]map[string][]schema.GroupVersionKind, srcPreferredVersions map[string]string) map[string]map[string]schema.GroupVersionKind {
+	unserved := map[string]map[string]schema.GroupVersionKind{}
+	for srcRes, srcGroupGVKs := range src {
+		for srcGroup, srcGVKs := range srcGroupGVKs {
+			dstGVKs, ok := dst[srcRes][srcGroup]
+			if !ok || !hasCommonGVKs(srcGVKs, dstGVKs) {
+				continue
+			}
+
+			preferred := preferredGVK(srcGVKs, srcPreferredVersions[srcGroup])
+			if hasCommonGVKs([]schema.GroupVersionKind{preferred}, dstGVKs) {
+				continue
+			}
+
+			if _, ok := unserved[srcRes]; !ok {
+				unserved[srcRes] = map[string]schema.GroupVersionKind{}
+			}
+			unserved[srcRes][srcGroup] = preferred
+		}
+	}
+	return unserved
+}
+
+// preferredGVK returns the GVK matching the group preferred version.
+// When the resource isn't served with the preferred version of its group,
+// the first GVK, which has the highest priority in the RESTMapper, is used instead.
+func preferredGVK(gvks []schema.GroupVersionKind, preferredVersion string) schema.GroupVersionKind {
+	if len(gvks) == 0 {
+		return schema.GroupVersionKind{}
+	}
+	for _, gvk := range gvks {
+		if gvk.Version == preferredVersion {
+			return gvk
+		}
+	}
+	return gvks[0]
+}
+
 func hasCommonGVKs(src, dst []schema.GroupVersionKind) bool {
 	for _, s := range src {
 		for _, d := range dst {
@@ -140,32 +412,36 @@ func hasSameGVKs(src, dst []schema.GroupVersionKind) bool {
 	return true
 }
 
-// listNamespacedResources parses provided list of RESTMapper.APIGroupRessources
-// then filters resources that are only namespaced
+// listResources parses provided list of server APIResourceLists
+// then filters resources that are namespaced or cluster-scoped, depending on namespaced
 // and trims out resources with suffixes extensions (such as */status, */rollback, */scale etc. I.E deployments/status)
 // and finaly returns GroupVersionKinds broken down by group for each resource.
-func listNamespacedResources(client *kubernetes.Clientset, restMapper meta.RESTMapper) map[string]map[string][]schema.GroupVersionKind {
-	//map[string][]schema.GroupVersionKind {
-	resources := api.ListServerResources(client)
+// Resources of the legacy core group (empty group name) are keyed as api.CoreGroup.
+// Resources whose kinds can't be found are recorded as errors and skipped.
+func (e *ClusterExtraction) listResources(resources []*metav1.APIResourceList, restMapper meta.RESTMapper, namespaced bool) map[string]map[string][]schema.GroupVersionKind {
 	list := make(map[string]map[string][]schema.GroupVersionKind)
+	failed := map[string]bool{}
 	for _, resource := range resources {
 		for _, APIResource := range resource.APIResources {
-			if APIResource.Namespaced {
+			if APIResource.Namespaced == namespaced {
 				name := APIResource.Name
 				last := strings.LastIndex(APIResource.Name, "/")
 				if last != -1 {
 					name = APIResource.Name[0:last]
 				}
 
-				if _, ok := list[name]; !ok {
+				if _, ok := list[name]; !ok && !failed[name] {
+					gvks, err := api.GetKindsFor(restMapper, name)
+					if err != nil {
+						e.AddError(err)
+						failed[name] = true
+						continue
+					}
+
 					list[name] = map[string][]schema.GroupVersionKind{}
-					gvks := api.GetKindsFor(restMapper, name)
 
 					for _, gvk := range gvks {
-						// TODO: Handle the case of empty group which corresponds to legacy "core"
-						if gvk.Group != "" {
-							list[name][gvk.Group] = getGVsFrom(gvks, gvk.Group)
-						}
+						list[name][api.GroupKey(gvk.Group)] = getGVsFrom(gvks, gvk.Group)
 					}
 				}
 			}
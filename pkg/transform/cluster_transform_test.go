@@ -0,0 +1,342 @@
+package transform
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	migv1alpha1 "github.com/fusor/mig-controller/pkg/apis/migration/v1alpha1"
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+func TestCompareRGVKs(t *testing.T) {
+	deploymentV1 := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	deploymentV1beta1 := schema.GroupVersionKind{Group: "apps", Version: "v1beta1", Kind: "Deployment"}
+	cronJobV1beta1 := schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
+	cronJobV2alpha1 := schema.GroupVersionKind{Group: "batch", Version: "v2alpha1", Kind: "CronJob"}
+	podV1 := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	routeV1 := schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+	testCases := []struct {
+		name            string
+		src             map[string]map[string][]schema.GroupVersionKind
+		dst             map[string]map[string][]schema.GroupVersionKind
+		expectedSrcOnly map[string]map[string][]schema.GroupVersionKind
+		expectedSrcGap  map[string]map[string][]schema.GroupVersionKind
+		expectedDstGap  map[string]map[string][]schema.GroupVersionKind
+	}{
+		{
+			name: "common GVKs and core group",
+			src: map[string]map[string][]schema.GroupVersionKind{
+				"deployments": {"apps": {deploymentV1, deploymentV1beta1}},
+				"pods":        {"core": {podV1}},
+			},
+			dst: map[string]map[string][]schema.GroupVersionKind{
+				"deployments": {"apps": {deploymentV1}},
+				"pods":        {"core": {podV1}},
+			},
+			expectedSrcOnly: map[string]map[string][]schema.GroupVersionKind{},
+			expectedSrcGap:  map[string]map[string][]schema.GroupVersionKind{},
+			expectedDstGap:  map[string]map[string][]schema.GroupVersionKind{},
+		},
+		{
+			name: "source only group and gap",
+			src: map[string]map[string][]schema.GroupVersionKind{
+				"cronjobs": {"batch": {cronJobV2alpha1}},
+				"routes":   {"route.openshift.io": {routeV1}},
+			},
+			dst: map[string]map[string][]schema.GroupVersionKind{
+				"cronjobs": {"batch": {cronJobV1beta1}},
+			},
+			expectedSrcOnly: map[string]map[string][]schema.GroupVersionKind{
+				"routes": {"route.openshift.io": {routeV1}},
+			},
+			expectedSrcGap: map[string]map[string][]schema.GroupVersionKind{
+				"cronjobs": {"batch": {cronJobV2alpha1}},
+			},
+			expectedDstGap: map[string]map[string][]schema.GroupVersionKind{
+				"cronjobs": {"batch": {cronJobV1beta1}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srcOnly, srcGap, dstGap := compareRGVKs(tc.src, tc.dst)
+			assert.Equal(t, tc.expectedSrcOnly, srcOnly)
+			assert.Equal(t, tc.expectedSrcGap, srcGap)
+			assert.Equal(t, tc.expectedDstGap, dstGap)
+		})
+	}
+}
+
+func TestUnservedPreferredRGVKs(t *testing.T) {
+	ingressV1beta1 := schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}
+	deploymentV1 := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	deploymentV1beta2 := schema.GroupVersionKind{Group: "apps", Version: "v1beta2", Kind: "Deployment"}
+	cronJobV1beta1 := schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
+	cronJobV2alpha1 := schema.GroupVersionKind{Group: "batch", Version: "v2alpha1", Kind: "CronJob"}
+
+	// cronjobs is a gap, no GVK is common
+	src := map[string]map[string][]schema.GroupVersionKind{
+		"cronjobs":    {"batch": {cronJobV2alpha1}},
+		"deployments": {"apps": {deploymentV1beta2, deploymentV1}},
+		"ingresses":   {"extensions": {ingressV1beta1}},
+	}
+	dst := map[string]map[string][]schema.GroupVersionKind{
+		"cronjobs":    {"batch": {cronJobV1beta1}},
+		"deployments": {"apps": {deploymentV1beta2}},
+	}
+
+	testCases := []struct {
+		name                string
+		srcPreferredVersion map[string]string
+		expectedUnserved    map[string]map[string]schema.GroupVersionKind
+	}{
+		{
+			name:                "preferred version served by destination",
+			srcPreferredVersion: map[string]string{"apps": "v1beta2", "batch": "v2alpha1"},
+			expectedUnserved:    map[string]map[string]schema.GroupVersionKind{},
+		},
+		{
+			name:                "preferred version not served by destination",
+			srcPreferredVersion: map[string]string{"apps": "v1", "batch": "v2alpha1"},
+			expectedUnserved: map[string]map[string]schema.GroupVersionKind{
+				"deployments": {"apps": deploymentV1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedUnserved, unservedPreferredRGVKs(src, dst, tc.srcPreferredVersion))
+		})
+	}
+}
+
+func TestRelocateRGs(t *testing.T) {
+	ingressExtensions := schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}
+	ingressNetworking := schema.GroupVersionKind{Group: "networking.k8s.io", Version: "v1beta1", Kind: "Ingress"}
+	routeV1 := schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+	srcOnly := map[string]map[string][]schema.GroupVersionKind{
+		"ingresses": {"extensions": {ingressExtensions}},
+		"routes":    {"route.openshift.io": {routeV1}},
+	}
+	dst := map[string]map[string][]schema.GroupVersionKind{
+		"ingresses": {"networking.k8s.io": {ingressNetworking}},
+	}
+
+	relocations := relocateRGs(srcOnly, dst, map[string]string{"networking.k8s.io": "v1beta1"})
+
+	assert.Equal(t, []api.Relocation{
+		{
+			ResourceName: "ingresses",
+			Source:       []schema.GroupVersionKind{ingressExtensions},
+			Destination:  []schema.GroupVersionKind{ingressNetworking},
+			Target:       ingressNetworking,
+		},
+	}, relocations)
+	assert.Equal(t, map[string]map[string][]schema.GroupVersionKind{
+		"routes": {"route.openshift.io": {routeV1}},
+	}, srcOnly)
+}
+
+func TestListResources(t *testing.T) {
+	podV1 := schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	deploymentV1 := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{podV1.GroupVersion(), deploymentV1.GroupVersion()})
+	restMapper.Add(podV1, meta.RESTScopeNamespace)
+	restMapper.Add(deploymentV1, meta.RESTScopeNamespace)
+
+	testCases := []struct {
+		name      string
+		resources []*metav1.APIResourceList
+		expected  map[string]map[string][]schema.GroupVersionKind
+		errors    []string
+	}{
+		{
+			name: "core group keyed as core",
+			resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Name: "pods", Namespaced: true, Kind: "Pod"},
+						{Name: "pods/status", Namespaced: true, Kind: "Pod"},
+					},
+				},
+			},
+			expected: map[string]map[string][]schema.GroupVersionKind{"pods": {"core": {podV1}}},
+		},
+		{
+			name: "named group",
+			resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "apps/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "deployments", Namespaced: true, Kind: "Deployment"},
+						{Name: "deployments/scale", Namespaced: true, Kind: "Scale"},
+					},
+				},
+			},
+			expected: map[string]map[string][]schema.GroupVersionKind{"deployments": {"apps": {deploymentV1}}},
+		},
+		{
+			name: "unknown resource",
+			resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Name: "brokens", Namespaced: true, Kind: "Broken"},
+						{Name: "brokens/status", Namespaced: true, Kind: "Broken"},
+					},
+				},
+			},
+			expected: map[string]map[string][]schema.GroupVersionKind{},
+			errors:   []string{"unable to get kinds for resource brokens"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			extraction := &ClusterExtraction{}
+			list := extraction.listResources(tc.resources, restMapper, true)
+
+			assert.Equal(t, tc.expected, list)
+			require.Len(t, extraction.Errors, len(tc.errors))
+			for i, err := range tc.errors {
+				assert.Contains(t, extraction.Errors[i], err)
+			}
+		})
+	}
+}
+
+func TestClusterTransformDifferential(t *testing.T) {
+	session := &api.Session{
+		Mode:        "Differential",
+		Source:      loadSnapshotCluster(t, "testdata/snapshot-src.json"),
+		Destination: loadSnapshotCluster(t, "testdata/snapshot-dst.json"),
+	}
+
+	extraction, err := ClusterTransform{Session: session}.Extract(context.Background())
+	require.NoError(t, err)
+	resources := extraction.(ClusterExtraction).Resources
+
+	cronJobV2alpha1 := schema.GroupVersionKind{Group: "batch", Version: "v2alpha1", Kind: "CronJob"}
+	cronJobV1beta1 := schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
+	ingressV1beta1 := schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}
+	networkingIngressV1beta1 := schema.GroupVersionKind{Group: "networking.k8s.io", Version: "v1beta1", Kind: "Ingress"}
+
+	assert.Equal(t, "source-example-com", resources.SrcClusterName)
+	assert.Equal(t, "destination-example-com", resources.DstClusterName)
+	assert.Equal(t, map[string]map[string][]schema.GroupVersionKind{"cronjobs": {"batch": {cronJobV2alpha1}}}, resources.SrcGapRGVKs)
+	assert.Equal(t, map[string]map[string][]schema.GroupVersionKind{"cronjobs": {"batch": {cronJobV1beta1}}}, resources.DstGapRGVKs)
+	// A gap isn't also reported as unserved preferred version
+	assert.NotContains(t, resources.UnservedPreferredRGVKs, "cronjobs")
+	// metrics.k8s.io/v1beta1 failed destination discovery, its source resources aren't reported as source only
+	assert.Contains(t, resources.SrcRGVKs["pods"], "metrics.k8s.io")
+	assert.Equal(t, map[string]map[string][]schema.GroupVersionKind{}, resources.SrcOnlyRGs)
+	assert.Equal(t, map[string]map[string][]schema.GroupVersionKind{}, resources.SrcOnlyClusterRGs)
+	assert.Equal(t, []api.Relocation{
+		{
+			ResourceName: "ingresses",
+			Source:       []schema.GroupVersionKind{ingressV1beta1},
+			Destination:  []schema.GroupVersionKind{networkingIngressV1beta1},
+			Target:       networkingIngressV1beta1,
+		},
+	}, resources.Relocations)
+	assert.Equal(t, []api.DiscoveryError{
+		{GroupVersion: "metrics.k8s.io/v1beta1", Message: "the server is currently unable to handle the request"},
+	}, resources.DstDiscoveryErrors)
+	assert.Empty(t, resources.Errors)
+}
+
+func TestClusterTransformDifferentialNamespaceUsage(t *testing.T) {
+	objects := map[string][]string{
+		"/apis/batch/v2alpha1/namespaces/app1/cronjobs":      {"nightly"},
+		"/apis/extensions/v1beta1/namespaces/app3/ingresses": {"web", "api"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/namespaces" {
+			assert.Equal(t, "team=a", r.URL.Query().Get("labelSelector"))
+			w.Write([]byte(`{"kind": "NamespaceList", "apiVersion": "v1", "metadata": {}, "items": [{"metadata": {"name": "app3"}}, {"metadata": {"name": "app1"}}]}`))
+			return
+		}
+
+		items := []string{}
+		for _, name := range objects[r.URL.Path] {
+			items = append(items, fmt.Sprintf(`{"kind": "PartialObjectMetadata", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {"name": %q}}`, name))
+		}
+		fmt.Fprintf(w, `{"kind": "PartialObjectMetadataList", "apiVersion": "meta.k8s.io/v1beta1", "metadata": {}, "items": [%s]}`, strings.Join(items, ","))
+	}))
+	defer server.Close()
+
+	snapshotCluster := loadSnapshotCluster(t, "testdata/snapshot-src.json")
+	source, err := api.NewClusterFromConfig(snapshotCluster.Name, &rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	source.Snapshot = snapshotCluster.Snapshot
+	source.RESTMapper = snapshotCluster.RESTMapper
+
+	session := &api.Session{
+		Mode:           "Differential",
+		Source:         source,
+		Destination:    loadSnapshotCluster(t, "testdata/snapshot-dst.json"),
+		NamespaceScope: api.NamespaceScope{Selector: "team=a"},
+	}
+
+	extraction, err := ClusterTransform{Session: session}.Extract(context.Background())
+	require.NoError(t, err)
+	resources := extraction.(ClusterExtraction).Resources
+
+	assert.Equal(t, []string{"app1", "app3"}, resources.Namespaces)
+	assert.Equal(t, map[string]map[string][]api.NamespaceUsage{
+		"cronjobs": {
+			"batch": {{Namespace: "app1", Count: 1, Objects: []string{"nightly"}}},
+		},
+		"ingresses": {
+			"extensions": {{Namespace: "app3", Count: 2, Objects: []string{"api", "web"}}},
+		},
+	}, resources.NamespaceUsage)
+	assert.Empty(t, resources.Errors)
+}
+
+func loadSnapshotCluster(t *testing.T, file string) *api.Cluster {
+	content, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+
+	snapshot, err := api.LoadSnapshot(content)
+	require.NoError(t, err)
+
+	return api.NewSnapshotCluster(snapshot)
+}
+
+func TestUsageSessionNamespaces(t *testing.T) {
+	source, server := newLiveCluster(t, "source", map[string]string{
+		"/api/v1/namespaces/app1": `{"kind": "Namespace", "apiVersion": "v1", "metadata": {"name": "app1"}}`,
+		"/api/v1/namespaces/app3": `{"kind": "Namespace", "apiVersion": "v1", "metadata": {"name": "app3"}}`,
+	})
+	defer server.Close()
+
+	// Mapped namespaces are looked for by source name
+	session := &api.Session{MigPlan: &migv1alpha1.MigPlan{
+		Spec: migv1alpha1.MigPlanSpec{Namespaces: []string{"app1:app2", "app3"}},
+	}}
+
+	errs := []error{}
+	lister := usageLister{source: source, addError: func(err error) { errs = append(errs, err) }}
+	assert.Equal(t, []string{"app1", "app3"}, lister.sessionNamespaces(session))
+	assert.Empty(t, errs)
+}
@@ -0,0 +1,91 @@
+package crd
+
+import (
+	"sort"
+)
+
+const (
+	// FieldRemoved is the change of a source field which destination schema doesn't describe, its values are dropped
+	FieldRemoved = "removed"
+	// FieldRetyped is the change of a field whose type differs on destination
+	FieldRetyped = "retyped"
+	// FieldRequired is the change of a field which is required on destination only
+	FieldRequired = "required"
+)
+
+// Change is a difference between source and destination schemas of a CRD version
+type Change struct {
+	Kind string `json:"kind"`
+	// Path is the path of the field, such as "spec.containers[*].image"
+	Path            string `json:"path"`
+	SourceType      string `json:"sourceType,omitempty"`
+	DestinationType string `json:"destinationType,omitempty"`
+}
+
+// Compare returns source fields removed or retyped by destination schema and the fields destination newly requires.
+// A version without schema accepts any object, only what destination schema requires is then reported.
+func Compare(src, dst *Schema) []Change {
+	if dst == nil {
+		return nil
+	}
+	if src == nil {
+		src = &Schema{}
+	}
+
+	changes := compare("", src, dst)
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Path != changes[j].Path {
+			return changes[i].Path < changes[j].Path
+		}
+		return changes[i].Kind < changes[j].Kind
+	})
+	return changes
+}
+
+func compare(path string, src, dst *Schema) []Change {
+	if src == nil || dst == nil {
+		return nil
+	}
+
+	srcType, dstType := src.TypeName(), dst.TypeName()
+	if srcType != "" && dstType != "" && srcType != dstType {
+		return []Change{{Kind: FieldRetyped, Path: path, SourceType: srcType, DestinationType: dstType}}
+	}
+
+	changes := []Change{}
+	for _, name := range dst.Required {
+		if contains(src.Required, name) {
+			continue
+		}
+
+		change := Change{Kind: FieldRequired, Path: fieldPath(path, name)}
+		if property := dst.field(name); property != nil {
+			change.DestinationType = property.TypeName()
+		}
+		changes = append(changes, change)
+	}
+
+	for name, srcProperty := range src.Properties {
+		dstProperty := dst.field(name)
+		if dstProperty == nil {
+			if !dst.allowsUnknownFields() {
+				changes = append(changes, Change{Kind: FieldRemoved, Path: fieldPath(path, name), SourceType: srcProperty.TypeName()})
+			}
+			continue
+		}
+		changes = append(changes, compare(fieldPath(path, name), srcProperty, dstProperty)...)
+	}
+
+	changes = append(changes, compare(path+"[*]", src.Items, dst.Items)...)
+	changes = append(changes, compare(fieldPath(path, "*"), src.AdditionalProperties, dst.AdditionalProperties)...)
+	return changes
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
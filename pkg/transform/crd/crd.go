@@ -0,0 +1,133 @@
+// Package crd compares the schemas of CustomResourceDefinitions defined on both source and destination clusters
+// and validates source objects against destination schemas.
+package crd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SectionName is the report section name of CRD schemas comparison
+const SectionName = "crd"
+
+const (
+	removedCategory  = "Removed field"
+	retypedCategory  = "Retyped field"
+	requiredCategory = "Required field"
+	invalidCategory  = "Invalid object"
+)
+
+// changeCategories are the finding categories of schema changes
+var changeCategories = map[string]string{
+	FieldRemoved:  removedCategory,
+	FieldRetyped:  retypedCategory,
+	FieldRequired: requiredCategory,
+}
+
+// categoryConfidence scores each category of findings:
+// - Invalid object: source object is rejected or loses fields on destination
+// - Removed, Retyped and Required field: source objects using or missing the field are affected
+var categoryConfidence = map[string]api.Confidence{
+	invalidCategory:  api.NoConfidence,
+	removedCategory:  api.ModerateConfidence,
+	retypedCategory:  api.ModerateConfidence,
+	requiredCategory: api.ModerateConfidence,
+}
+
+// ReportCRD represents json report of the CRDs whose schema differs between source and destination clusters
+type ReportCRD struct {
+	SrcClusterName string `json:"sourceClusterName,omitempty"`
+	DstClusterName string `json:"destinationClusterName,omitempty"`
+	// Namespaces are the source namespaces whose objects are validated against destination schemas
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Compared is the number of CRDs defined on both clusters
+	Compared    int                `json:"compared"`
+	Definitions []ReportDefinition `json:"definitions,omitempty"`
+	Errors      []string           `json:"errors,omitempty"`
+}
+
+// ReportDefinition represents json data of a CRD with versions whose schema differs
+type ReportDefinition struct {
+	Name       string          `json:"name"`
+	Resource   string          `json:"resource"`
+	Namespaced bool            `json:"namespaced"`
+	Versions   []ReportVersion `json:"versions"`
+}
+
+// ReportVersion represents json data of schema changes of a CRD version and the source objects they affect
+type ReportVersion struct {
+	GVK     schema.GroupVersionKind `json:"gvk"`
+	Changes []Change                `json:"changes"`
+	// InvalidObjects are source objects which don't match destination schema
+	InvalidObjects []ReportObject `json:"invalidObjects,omitempty"`
+}
+
+// ReportObject represents json data of a source object and its fields not matching destination schema
+type ReportObject struct {
+	Namespace  string      `json:"namespace"`
+	Name       string      `json:"name"`
+	Violations []Violation `json:"violations"`
+}
+
+// Message describes a schema change
+func (c Change) Message() string {
+	switch c.Kind {
+	case FieldRemoved:
+		return fmt.Sprintf("field %s isn't in destination schema, its values are dropped", c.Path)
+	case FieldRetyped:
+		return fmt.Sprintf("field %s changes type from %s to %s", c.Path, c.SourceType, c.DestinationType)
+	case FieldRequired:
+		return fmt.Sprintf("field %s is required by destination schema", c.Path)
+	}
+	return c.Path
+}
+
+// Findings flattens CRD report, each schema change and each invalid object is a finding
+func (r ReportCRD) Findings() []finding.Finding {
+	findings := []finding.Finding{}
+	for _, definition := range r.Definitions {
+		scope := finding.ClusterScope
+		if definition.Namespaced {
+			scope = finding.NamespacedScope
+		}
+
+		for _, version := range definition.Versions {
+			versionFinding := finding.Finding{
+				Section:  SectionName,
+				Scope:    scope,
+				Resource: definition.Resource,
+				Group:    api.GroupKey(version.GVK.Group),
+				Source:   finding.GVK(version.GVK),
+			}
+
+			for _, change := range version.Changes {
+				changeFinding := versionFinding
+				changeFinding.Category = changeCategories[change.Kind]
+				changeFinding.Confidence = categoryConfidence[changeFinding.Category]
+				changeFinding.Message = change.Message()
+				findings = append(findings, changeFinding)
+			}
+
+			for _, object := range version.InvalidObjects {
+				violations := make([]string, 0, len(object.Violations))
+				for _, violation := range object.Violations {
+					violations = append(violations, violation.String())
+				}
+
+				objectFinding := versionFinding
+				objectFinding.Category = invalidCategory
+				objectFinding.Confidence = categoryConfidence[invalidCategory]
+				objectFinding.Namespaces = []string{object.Namespace}
+				objectFinding.Object = object.Name
+				objectFinding.Message = strings.Join(violations, ", ")
+				findings = append(findings, objectFinding)
+			}
+		}
+	}
+	return findings
+}
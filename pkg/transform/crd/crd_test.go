@@ -0,0 +1,110 @@
+package crd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestParseDefinition(t *testing.T) {
+	testCases := []struct {
+		name     string
+		object   string
+		expected []string
+	}{
+		{
+			name: "v1",
+			object: `{"apiVersion": "apiextensions.k8s.io/v1", "kind": "CustomResourceDefinition", "metadata": {"name": "databases.example.com"},
+			  "spec": {"group": "example.com", "scope": "Namespaced", "names": {"plural": "databases", "kind": "Database"},
+			    "versions": [
+			      {"name": "v1beta1", "served": true, "schema": {"openAPIV3Schema": {"type": "object", "properties": {"spec": {"type": "object"}}}}},
+			      {"name": "v1alpha1", "served": true, "schema": {"openAPIV3Schema": {"type": "object"}}},
+			      {"name": "v0", "served": false}
+			    ]}}`,
+			expected: []string{"v1alpha1", "v1beta1 spec"},
+		},
+		{
+			name: "v1beta1 with common schema",
+			object: `{"apiVersion": "apiextensions.k8s.io/v1beta1", "kind": "CustomResourceDefinition", "metadata": {"name": "databases.example.com"},
+			  "spec": {"group": "example.com", "scope": "Namespaced", "names": {"plural": "databases", "kind": "Database"}, "version": "v1alpha1",
+			    "validation": {"openAPIV3Schema": {"type": "object", "properties": {"spec": {"type": "object"}}}}}}`,
+			expected: []string{"v1alpha1 spec"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			object := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal([]byte(tc.object), &object))
+
+			definition, err := ParseDefinition(object)
+			require.NoError(t, err)
+			assert.Equal(t, "databases.example.com", definition.Name)
+			assert.Equal(t, "databases", definition.Resource)
+			assert.True(t, definition.Namespaced)
+			assert.Equal(t, schema.GroupVersionKind{Group: "example.com", Version: "v1alpha1", Kind: "Database"}, definition.GroupVersionKind("v1alpha1"))
+
+			versions := []string{}
+			for _, version := range definition.Versions {
+				require.NotNil(t, version.Schema)
+				for property := range version.Schema.Properties {
+					version.Name += " " + property
+				}
+				versions = append(versions, version.Name)
+			}
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
+func TestFindings(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1alpha1", Kind: "Database"}
+	report := ReportCRD{
+		Definitions: []ReportDefinition{{
+			Name:       "databases.example.com",
+			Resource:   "databases",
+			Namespaced: true,
+			Versions: []ReportVersion{{
+				GVK:     gvk,
+				Changes: []Change{{Kind: FieldRetyped, Path: "spec.replicas", SourceType: "string", DestinationType: "integer"}},
+				InvalidObjects: []ReportObject{{
+					Namespace:  "app1",
+					Name:       "orders",
+					Violations: []Violation{{Path: "spec.replicas", Message: "must be integer, found string"}},
+				}},
+			}},
+		}},
+	}
+
+	expected := []finding.Finding{
+		{
+			Section:    SectionName,
+			Category:   retypedCategory,
+			Scope:      finding.NamespacedScope,
+			Resource:   "databases",
+			Group:      "example.com",
+			Source:     "example.com/v1alpha1 Database",
+			Confidence: api.ModerateConfidence,
+			Message:    "field spec.replicas changes type from string to integer",
+		},
+		{
+			Section:    SectionName,
+			Category:   invalidCategory,
+			Scope:      finding.NamespacedScope,
+			Resource:   "databases",
+			Group:      "example.com",
+			Namespaces: []string{"app1"},
+			Object:     "orders",
+			Source:     "example.com/v1alpha1 Database",
+			Confidence: api.NoConfidence,
+			Message:    "spec.replicas: must be integer, found string",
+		},
+	}
+	assert.Equal(t, expected, report.Findings())
+}
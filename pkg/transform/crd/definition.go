@@ -0,0 +1,123 @@
+package crd
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Definition is a CustomResourceDefinition read from either apiextensions.k8s.io/v1 or v1beta1
+type Definition struct {
+	Name       string
+	Group      string
+	Kind       string
+	Resource   string
+	Namespaced bool
+	// Versions are the served versions, sorted by name
+	Versions []DefinitionVersion
+}
+
+// DefinitionVersion is a served version of a CRD and its schema, nil when the version has none
+type DefinitionVersion struct {
+	Name   string
+	Schema *Schema
+}
+
+// definition is the CRD content read by ParseDefinition, fields of both v1 and v1beta1
+type definition struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		Group string `json:"group"`
+		Names struct {
+			Plural string `json:"plural"`
+			Kind   string `json:"kind"`
+		} `json:"names"`
+		Scope string `json:"scope"`
+		// Version and Validation are the v1beta1 version and schema used when versions don't set them
+		Version    string            `json:"version"`
+		Validation *validation       `json:"validation"`
+		Versions   []versionOfSchema `json:"versions"`
+	} `json:"spec"`
+}
+
+type validation struct {
+	OpenAPIV3Schema *Schema `json:"openAPIV3Schema"`
+}
+
+type versionOfSchema struct {
+	Name   string      `json:"name"`
+	Served bool        `json:"served"`
+	Schema *validation `json:"schema"`
+}
+
+// ParseDefinition reads a CRD from its unstructured content
+func ParseDefinition(object map[string]interface{}) (Definition, error) {
+	content, err := json.Marshal(object)
+	if err != nil {
+		return Definition{}, err
+	}
+
+	parsed := definition{}
+	if err := json.Unmarshal(content, &parsed); err != nil {
+		return Definition{}, errors.Wrap(err, "unable to read CustomResourceDefinition")
+	}
+
+	result := Definition{
+		Name:       parsed.Metadata.Name,
+		Group:      parsed.Spec.Group,
+		Kind:       parsed.Spec.Names.Kind,
+		Resource:   parsed.Spec.Names.Plural,
+		Namespaced: parsed.Spec.Scope == "Namespaced",
+	}
+
+	var commonSchema *Schema
+	if parsed.Spec.Validation != nil {
+		commonSchema = parsed.Spec.Validation.OpenAPIV3Schema
+	}
+
+	versions := parsed.Spec.Versions
+	if len(versions) == 0 && parsed.Spec.Version != "" {
+		versions = []versionOfSchema{{Name: parsed.Spec.Version, Served: true}}
+	}
+
+	for _, version := range versions {
+		if !version.Served {
+			continue
+		}
+
+		crdVersion := DefinitionVersion{Name: version.Name, Schema: commonSchema}
+		if version.Schema != nil && version.Schema.OpenAPIV3Schema != nil {
+			crdVersion.Schema = version.Schema.OpenAPIV3Schema
+		}
+		result.Versions = append(result.Versions, crdVersion)
+	}
+	sort.Slice(result.Versions, func(i, j int) bool {
+		return result.Versions[i].Name < result.Versions[j].Name
+	})
+	return result, nil
+}
+
+// Version returns a served version of the CRD
+func (d Definition) Version(name string) (DefinitionVersion, bool) {
+	for _, version := range d.Versions {
+		if version.Name == name {
+			return version, true
+		}
+	}
+	return DefinitionVersion{}, false
+}
+
+// GroupVersionResource returns the GVR objects of a version are listed with
+func (d Definition) GroupVersionResource(version string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: d.Group, Version: version, Resource: d.Resource}
+}
+
+// GroupVersionKind returns the GVK of objects of a version
+func (d Definition) GroupVersionKind(version string) schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: d.Group, Version: version, Kind: d.Kind}
+}
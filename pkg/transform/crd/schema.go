@@ -0,0 +1,132 @@
+package crd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Schema is the part of a CRD OpenAPI v3 schema used to compare schemas and validate objects
+type Schema struct {
+	Type       string             `json:"type,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	// Items is the schema of array items, tuples of item schemas aren't supported
+	Items *Schema `json:"-"`
+	// AdditionalProperties is the schema of map values
+	AdditionalProperties *Schema `json:"-"`
+	// AllowsAdditionalProperties is true when additionalProperties is set, with or without a schema
+	AllowsAdditionalProperties bool `json:"-"`
+	XPreserveUnknownFields     bool `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+	XIntOrString               bool `json:"x-kubernetes-int-or-string,omitempty"`
+	XEmbeddedResource          bool `json:"x-kubernetes-embedded-resource,omitempty"`
+}
+
+// embeddedFields are the fields of objects and embedded resources which aren't described by their schema
+var embeddedFields = map[string]bool{"apiVersion": true, "kind": true, "metadata": true}
+
+// UnmarshalJSON reads a schema, items and additionalProperties can either be a schema or another JSON value
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type schema Schema
+	raw := struct {
+		*schema
+		Items                json.RawMessage `json:"items,omitempty"`
+		AdditionalProperties json.RawMessage `json:"additionalProperties,omitempty"`
+	}{schema: (*schema)(s)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if isObject(raw.Items) {
+		s.Items = &Schema{}
+		if err := json.Unmarshal(raw.Items, s.Items); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case isObject(raw.AdditionalProperties):
+		s.AdditionalProperties = &Schema{}
+		if err := json.Unmarshal(raw.AdditionalProperties, s.AdditionalProperties); err != nil {
+			return err
+		}
+		s.AllowsAdditionalProperties = true
+	case bytes.Equal(bytes.TrimSpace(raw.AdditionalProperties), []byte("true")):
+		s.AllowsAdditionalProperties = true
+	}
+	return nil
+}
+
+func isObject(data json.RawMessage) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '{'
+}
+
+// TypeName returns the type of values, "int-or-string" for values which can be either, empty for any type
+func (s *Schema) TypeName() string {
+	if s.XIntOrString {
+		return "int-or-string"
+	}
+	return s.Type
+}
+
+// allowsUnknownFields returns true when object fields not described by the schema are kept.
+// Objects without any property described are free-form.
+func (s *Schema) allowsUnknownFields() bool {
+	return s.XPreserveUnknownFields || s.AllowsAdditionalProperties || len(s.Properties) == 0
+}
+
+// field returns the schema of an object field, nil when the field isn't described
+func (s *Schema) field(name string) *Schema {
+	if property, ok := s.Properties[name]; ok {
+		return property
+	}
+	return s.AdditionalProperties
+}
+
+// accepts returns true when value has the type of the schema
+func (s *Schema) accepts(value interface{}) bool {
+	valueType := typeOf(value)
+	switch s.TypeName() {
+	case "":
+		return true
+	case "int-or-string":
+		return valueType == "integer" || valueType == "string"
+	case "number":
+		return valueType == "integer" || valueType == "number"
+	default:
+		return valueType == s.TypeName()
+	}
+}
+
+// typeOf returns the OpenAPI type of a value decoded from JSON
+func typeOf(value interface{}) string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int, int32, int64:
+		return "integer"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// fieldPath returns the path of an object field, such as "spec.replicas"
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
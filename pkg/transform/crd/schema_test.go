@@ -0,0 +1,141 @@
+package crd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const srcSchema = `{
+  "type": "object",
+  "properties": {
+    "spec": {
+      "type": "object",
+      "properties": {
+        "replicas": {"type": "string"},
+        "storageSize": {"type": "string"},
+        "port": {"x-kubernetes-int-or-string": true},
+        "users": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string"}, "role": {"type": "string"}}}},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+        "config": {"type": "object"}
+      }
+    }
+  }
+}`
+
+const dstSchema = `{
+  "type": "object",
+  "properties": {
+    "spec": {
+      "type": "object",
+      "required": ["engine"],
+      "properties": {
+        "engine": {"type": "string"},
+        "replicas": {"type": "integer"},
+        "port": {"x-kubernetes-int-or-string": true},
+        "users": {"type": "array", "items": {"type": "object", "required": ["name"], "properties": {"name": {"type": "string"}}}},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+        "config": {"type": "object", "x-kubernetes-preserve-unknown-fields": true}
+      }
+    },
+    "status": {"type": "object", "x-kubernetes-preserve-unknown-fields": true}
+  }
+}`
+
+func TestUnmarshalSchema(t *testing.T) {
+	schema := parseSchema(t, `{"type": "object", "additionalProperties": true, "properties": {"ports": {"type": "array", "items": [{"type": "integer"}]}}}`)
+
+	assert.True(t, schema.AllowsAdditionalProperties)
+	assert.Nil(t, schema.AdditionalProperties)
+	require.Contains(t, schema.Properties, "ports")
+	assert.Nil(t, schema.Properties["ports"].Items)
+
+	dst := parseSchema(t, dstSchema)
+	assert.Equal(t, "integer", dst.Properties["spec"].Properties["replicas"].TypeName())
+	assert.Equal(t, "int-or-string", dst.Properties["spec"].Properties["port"].TypeName())
+	assert.Equal(t, []string{"name"}, dst.Properties["spec"].Properties["users"].Items.Required)
+	assert.Equal(t, "string", dst.Properties["spec"].Properties["labels"].AdditionalProperties.Type)
+}
+
+func TestCompare(t *testing.T) {
+	src, dst := parseSchema(t, srcSchema), parseSchema(t, dstSchema)
+
+	testCases := []struct {
+		name     string
+		src      *Schema
+		dst      *Schema
+		expected []Change
+	}{
+		{
+			name: "changed schema",
+			src:  src,
+			dst:  dst,
+			expected: []Change{
+				{Kind: FieldRequired, Path: "spec.engine", DestinationType: "string"},
+				{Kind: FieldRetyped, Path: "spec.replicas", SourceType: "string", DestinationType: "integer"},
+				{Kind: FieldRemoved, Path: "spec.storageSize", SourceType: "string"},
+				{Kind: FieldRequired, Path: "spec.users[*].name", DestinationType: "string"},
+				{Kind: FieldRemoved, Path: "spec.users[*].role", SourceType: "string"},
+			},
+		},
+		{name: "same schema", src: src, dst: parseSchema(t, srcSchema), expected: []Change{}},
+		{name: "destination without schema", src: src, dst: nil},
+		{
+			name:     "source without schema",
+			src:      nil,
+			dst:      parseSchema(t, `{"type": "object", "required": ["spec"], "properties": {"spec": {"type": "object"}}}`),
+			expected: []Change{{Kind: FieldRequired, Path: "spec", DestinationType: "object"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Compare(tc.src, tc.dst))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	dst := parseSchema(t, dstSchema)
+
+	testCases := []struct {
+		name     string
+		object   string
+		expected []Violation
+	}{
+		{
+			name:     "valid object",
+			object:   `{"apiVersion": "example.com/v1alpha1", "kind": "Database", "metadata": {"name": "orders"}, "spec": {"engine": "postgres", "replicas": 3, "port": "db", "config": {"any": 1}}, "status": {"ready": true}}`,
+			expected: []Violation{},
+		},
+		{
+			name:   "invalid object",
+			object: `{"metadata": {"name": "orders"}, "spec": {"replicas": "3", "storageSize": "1Gi", "port": 5.5, "users": [{"name": "admin"}, {"role": "reader"}], "labels": {"team": 1}}}`,
+			expected: []Violation{
+				{Path: "spec.engine", Message: "required field is missing"},
+				{Path: "spec.labels.team", Message: "must be string, found integer"},
+				{Path: "spec.port", Message: "must be int-or-string, found number"},
+				{Path: "spec.replicas", Message: "must be integer, found string"},
+				{Path: "spec.storageSize", Message: "unknown field is dropped"},
+				{Path: "spec.users[1].name", Message: "required field is missing"},
+				{Path: "spec.users[1].role", Message: "unknown field is dropped"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			object := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal([]byte(tc.object), &object))
+			assert.Equal(t, tc.expected, Validate(object, dst))
+		})
+	}
+}
+
+func parseSchema(t *testing.T, content string) *Schema {
+	schema := &Schema{}
+	require.NoError(t, json.Unmarshal([]byte(content), schema))
+	return schema
+}
@@ -0,0 +1,73 @@
+package crd
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Violation is a field of an object which doesn't match a schema
+type Violation struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// Validate returns the fields of an object which are dropped, have another type or are missing according to schema.
+// apiVersion, kind and metadata of objects and embedded resources are left to the API server.
+func Validate(object map[string]interface{}, schema *Schema) []Violation {
+	if schema == nil {
+		return nil
+	}
+
+	violations := validate("", object, schema, true)
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+	return violations
+}
+
+func validate(path string, value interface{}, schema *Schema, embedded bool) []Violation {
+	if schema == nil || value == nil {
+		return nil
+	}
+
+	if !schema.accepts(value) {
+		return []Violation{{Path: path, Message: fmt.Sprintf("must be %s, found %s", schema.TypeName(), typeOf(value))}}
+	}
+
+	violations := []Violation{}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, ok := v[name]; !ok {
+				violations = append(violations, Violation{Path: fieldPath(path, name), Message: "required field is missing"})
+			}
+		}
+
+		for name, fieldValue := range v {
+			if embedded && embeddedFields[name] {
+				continue
+			}
+
+			property := schema.field(name)
+			if property == nil {
+				if !schema.allowsUnknownFields() {
+					violations = append(violations, Violation{Path: fieldPath(path, name), Message: "unknown field is dropped"})
+				}
+				continue
+			}
+			violations = append(violations, validate(fieldPath(path, name), fieldValue, property, property.XEmbeddedResource)...)
+		}
+	case []interface{}:
+		if schema.Items == nil {
+			break
+		}
+		for i, item := range v {
+			violations = append(violations, validate(fmt.Sprintf("%s[%d]", path, i), item, schema.Items, schema.Items.XEmbeddedResource)...)
+		}
+	}
+	return violations
+}
@@ -0,0 +1,175 @@
+package transform
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/crd"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// CRDTransformName is the CRD schemas report name
+const CRDTransformName = "CRD"
+
+// CRDExtraction holds CRDs whose schema differs between source and destination clusters
+type CRDExtraction struct {
+	crd.ReportCRD
+}
+
+// CRDTransform compares schemas of CRDs defined on both clusters, for each version served by both
+type CRDTransform struct {
+	Session *api.Session
+}
+
+// Transform converts the retrieved information to a useful output
+func (e CRDExtraction) Transform() ([]reportoutput.Section, error) {
+	logrus.Info("CRDTransform::Transform:Reports")
+	return []reportoutput.Section{{Name: crd.SectionName, Content: e.ReportCRD}}, nil
+}
+
+// Validate no need to validate it, data is exctracted from API
+func (e CRDExtraction) Validate() (err error) { return }
+
+// Extract fetches CRDs from both clusters, compares their schemas
+// and validates source objects of changed versions against destination schemas
+func (e CRDTransform) Extract(ctx context.Context) (Extraction, error) {
+	src, dst := e.Session.Source, e.Session.Destination
+
+	// CRDs are neither in snapshots nor in reference profiles
+	for _, cluster := range []*api.Cluster{src, dst} {
+		if !cluster.IsLive() {
+			return nil, errors.Errorf("CustomResourceDefinitions of %s can't be compared, they are only fetched from a live cluster", cluster.Name)
+		}
+	}
+
+	extraction := &CRDExtraction{}
+	extraction.SrcClusterName = src.Name
+	extraction.DstClusterName = dst.Name
+
+	srcDefinitions, err := e.listDefinitions(src, extraction.addError)
+	if err != nil {
+		return nil, err
+	}
+
+	dstDefinitions, err := e.listDefinitions(dst, extraction.addError)
+	if err != nil {
+		return nil, err
+	}
+
+	lister := usageLister{source: src, addError: extraction.addError}
+	extraction.Namespaces = lister.sessionNamespaces(e.Session)
+
+	for _, srcDefinition := range srcDefinitions {
+		// CRDs missing on destination are reported by the cluster check
+		dstDefinition, ok := findDefinition(dstDefinitions, srcDefinition.Name)
+		if !ok {
+			continue
+		}
+		extraction.Compared++
+
+		reportDefinition := crd.ReportDefinition{
+			Name:       srcDefinition.Name,
+			Resource:   srcDefinition.Resource,
+			Namespaced: srcDefinition.Namespaced,
+		}
+		for _, srcVersion := range srcDefinition.Versions {
+			dstVersion, ok := dstDefinition.Version(srcVersion.Name)
+			if !ok {
+				continue
+			}
+
+			changes := crd.Compare(srcVersion.Schema, dstVersion.Schema)
+			if len(changes) == 0 {
+				continue
+			}
+
+			reportVersion := crd.ReportVersion{GVK: srcDefinition.GroupVersionKind(srcVersion.Name), Changes: changes}
+			if srcDefinition.Namespaced {
+				reportVersion.InvalidObjects = e.invalidObjects(ctx, extraction.Namespaces, srcDefinition, srcVersion.Name, dstVersion.Schema, extraction.addError)
+			}
+			reportDefinition.Versions = append(reportDefinition.Versions, reportVersion)
+		}
+
+		if len(reportDefinition.Versions) > 0 {
+			extraction.Definitions = append(extraction.Definitions, reportDefinition)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return *extraction, nil
+}
+
+// listDefinitions returns the CRDs of a cluster sorted by name, CRDs which can't be read are recorded with addError
+func (e CRDTransform) listDefinitions(cluster *api.Cluster, addError func(error)) ([]crd.Definition, error) {
+	objects, err := api.ListCustomResourceDefinitions(cluster.DynClient, usagePageSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "CustomResourceDefinitions of %s", cluster.Name)
+	}
+
+	definitions := []crd.Definition{}
+	for _, object := range objects {
+		definition, err := crd.ParseDefinition(object.Object)
+		if err != nil {
+			addError(errors.Wrapf(err, "%s of %s", object.GetName(), cluster.Name))
+			continue
+		}
+		definitions = append(definitions, definition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Name < definitions[j].Name
+	})
+	return definitions, nil
+}
+
+func findDefinition(definitions []crd.Definition, name string) (crd.Definition, bool) {
+	for _, definition := range definitions {
+		if definition.Name == name {
+			return definition, true
+		}
+	}
+	return crd.Definition{}, false
+}
+
+// invalidObjects returns source objects of a CRD version in namespaces which don't match destination schema.
+// It stops listing once ctx is done.
+func (e CRDTransform) invalidObjects(ctx context.Context, namespaces []string, definition crd.Definition, version string, schema *crd.Schema, addError func(error)) []crd.ReportObject {
+	invalid := []crd.ReportObject{}
+	for _, namespace := range namespaces {
+		if ctx.Err() != nil {
+			break
+		}
+
+		objects, err := api.ListObjects(e.Session.Source.DynClient, definition.GroupVersionResource(version), namespace, usagePageSize)
+		if err != nil {
+			addError(err)
+			continue
+		}
+
+		sort.Slice(objects, func(i, j int) bool {
+			return objects[i].GetName() < objects[j].GetName()
+		})
+		for _, object := range objects {
+			if violations := crd.Validate(object.Object, schema); len(violations) > 0 {
+				invalid = append(invalid, crd.ReportObject{Namespace: namespace, Name: object.GetName(), Violations: violations})
+			}
+		}
+	}
+	return invalid
+}
+
+func (e *CRDExtraction) addError(err error) {
+	logrus.Warn(err)
+	e.Errors = append(e.Errors, err.Error())
+}
+
+// Name returns a human readable name for the transform
+func (e CRDTransform) Name() string {
+	return CRDTransformName
+}
@@ -0,0 +1,115 @@
+package transform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/crd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+func TestCRDTransform(t *testing.T) {
+	srcResponses := map[string]string{
+		"/api/v1/namespaces/app1": `{"kind": "Namespace", "apiVersion": "v1", "metadata": {"name": "app1"}}`,
+		"/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions": `{"kind": "CustomResourceDefinitionList", "apiVersion": "apiextensions.k8s.io/v1beta1", "metadata": {}, "items": [
+		  {"kind": "CustomResourceDefinition", "apiVersion": "apiextensions.k8s.io/v1beta1", "metadata": {"name": "databases.example.com"},
+		   "spec": {"group": "example.com", "scope": "Namespaced", "names": {"plural": "databases", "kind": "Database"}, "version": "v1alpha1",
+		     "validation": {"openAPIV3Schema": {"type": "object", "properties": {"spec": {"type": "object", "properties": {"replicas": {"type": "string"}, "engine": {"type": "string"}}}}}}}},
+		  {"kind": "CustomResourceDefinition", "apiVersion": "apiextensions.k8s.io/v1beta1", "metadata": {"name": "caches.example.com"},
+		   "spec": {"group": "example.com", "scope": "Namespaced", "names": {"plural": "caches", "kind": "Cache"}, "version": "v1"}},
+		  {"kind": "CustomResourceDefinition", "apiVersion": "apiextensions.k8s.io/v1beta1", "metadata": {"name": "queues.example.com"},
+		   "spec": {"group": "example.com", "scope": "Namespaced", "names": {"plural": "queues", "kind": "Queue"}, "version": "v1"}}
+		]}`,
+		"/apis/example.com/v1alpha1/namespaces/app1/databases": `{"kind": "DatabaseList", "apiVersion": "example.com/v1alpha1", "metadata": {}, "items": [
+		  {"kind": "Database", "apiVersion": "example.com/v1alpha1", "metadata": {"name": "orders", "namespace": "app1"}, "spec": {"replicas": "3", "engine": "postgres"}},
+		  {"kind": "Database", "apiVersion": "example.com/v1alpha1", "metadata": {"name": "archive", "namespace": "app1"}, "spec": {"engine": "postgres"}}
+		]}`,
+	}
+	dstResponses := map[string]string{
+		"/apis/apiextensions.k8s.io/v1/customresourcedefinitions": `{"kind": "CustomResourceDefinitionList", "apiVersion": "apiextensions.k8s.io/v1", "metadata": {}, "items": [
+		  {"kind": "CustomResourceDefinition", "apiVersion": "apiextensions.k8s.io/v1", "metadata": {"name": "databases.example.com"},
+		   "spec": {"group": "example.com", "scope": "Namespaced", "names": {"plural": "databases", "kind": "Database"}, "versions": [
+		     {"name": "v1alpha1", "served": true, "schema": {"openAPIV3Schema": {"type": "object", "properties": {"spec": {"type": "object", "properties": {"replicas": {"type": "integer"}, "engine": {"type": "string"}}}}}}}]}},
+		  {"kind": "CustomResourceDefinition", "apiVersion": "apiextensions.k8s.io/v1", "metadata": {"name": "caches.example.com"},
+		   "spec": {"group": "example.com", "scope": "Namespaced", "names": {"plural": "caches", "kind": "Cache"}, "versions": [{"name": "v1", "served": true}]}}
+		]}`,
+	}
+
+	source, srcServer := newLiveCluster(t, "source", srcResponses)
+	defer srcServer.Close()
+	destination, dstServer := newLiveCluster(t, "destination", dstResponses)
+	defer dstServer.Close()
+
+	session := &api.Session{
+		Mode:           "Differential",
+		Source:         source,
+		Destination:    destination,
+		NamespaceScope: api.NamespaceScope{Names: []string{"app1"}},
+	}
+
+	extraction, err := CRDTransform{Session: session}.Extract(context.Background())
+	require.NoError(t, err)
+
+	report := extraction.(CRDExtraction).ReportCRD
+	assert.Equal(t, crd.ReportCRD{
+		SrcClusterName: "source",
+		DstClusterName: "destination",
+		Namespaces:     []string{"app1"},
+		Compared:       2,
+		Definitions: []crd.ReportDefinition{{
+			Name:       "databases.example.com",
+			Resource:   "databases",
+			Namespaced: true,
+			Versions: []crd.ReportVersion{{
+				GVK:     schema.GroupVersionKind{Group: "example.com", Version: "v1alpha1", Kind: "Database"},
+				Changes: []crd.Change{{Kind: crd.FieldRetyped, Path: "spec.replicas", SourceType: "string", DestinationType: "integer"}},
+				InvalidObjects: []crd.ReportObject{{
+					Namespace:  "app1",
+					Name:       "orders",
+					Violations: []crd.Violation{{Path: "spec.replicas", Message: "must be integer, found string"}},
+				}},
+			}},
+		}},
+	}, report)
+}
+
+func TestCRDTransformSnapshot(t *testing.T) {
+	session := &api.Session{
+		Mode:        "Differential",
+		Source:      loadSnapshotCluster(t, "testdata/snapshot-src.json"),
+		Destination: loadSnapshotCluster(t, "testdata/snapshot-dst.json"),
+	}
+
+	_, err := CRDTransform{Session: session}.Extract(context.Background())
+	assert.EqualError(t, err, "CustomResourceDefinitions of source-example-com can't be compared, they are only fetched from a live cluster")
+}
+
+// newLiveCluster returns a cluster and its API server answering requests with responses by path, other paths aren't found.
+// Next pages of a list are keyed by path and continue token, such as "/api/v1/namespaces/app1/configmaps?continue=2".
+func newLiveCluster(t *testing.T, name string, responses map[string]string) (*api.Cluster, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		key := r.URL.Path
+		if token := r.URL.Query().Get("continue"); token != "" {
+			key += "?continue=" + token
+		}
+		response, ok := responses[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "metadata": {}, "status": "Failure", "reason": "NotFound", "code": 404}`))
+			return
+		}
+		w.Write([]byte(response))
+	}))
+
+	cluster, err := api.NewClusterFromConfig(name, &rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return cluster, server
+}
@@ -0,0 +1,129 @@
+package deprecation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SectionName is the report section name of deprecated APIs
+const SectionName = "deprecation"
+
+const (
+	deprecatedCategory = "Deprecated API"
+	removedCategory    = "Removed API"
+)
+
+// categoryConfidence scores each category of findings:
+// - Removed API: target release doesn't serve the GVK, manifests and backups using it can't be applied
+// - Deprecated API: target release still serves the GVK, it must be replaced before a later upgrade
+var categoryConfidence = map[string]api.Confidence{
+	removedCategory:    api.NoConfidence,
+	deprecatedCategory: api.ModerateConfidence,
+}
+
+// ReportDeprecation represents json report of source cluster APIs deprecated or removed by the target release
+type ReportDeprecation struct {
+	ClusterName   string  `json:"clusterName,omitempty"`
+	SourceVersion Version `json:"sourceVersion"`
+	TargetVersion Version `json:"targetVersion"`
+	// TargetProfile is the reference profile target version comes from instead of a real destination cluster
+	TargetProfile string `json:"targetProfile,omitempty"`
+	// Namespaces are the source namespaces looked into for objects of deprecated APIs
+	Namespaces []string         `json:"namespaces,omitempty"`
+	Resources  []ReportResource `json:"resources,omitempty"`
+	Errors     []string         `json:"errors,omitempty"`
+}
+
+// ReportResource represents json data of a GVK served by source cluster and deprecated by the target release
+type ReportResource struct {
+	Resource     string                   `json:"resource"`
+	Namespaced   bool                     `json:"namespaced"`
+	GVK          schema.GroupVersionKind  `json:"gvk"`
+	DeprecatedIn Version                  `json:"deprecatedIn"`
+	RemovedIn    Version                  `json:"removedIn"`
+	Replacement  *schema.GroupVersionKind `json:"replacement,omitempty"`
+	// Removed is true when the target release doesn't serve the GVK anymore
+	Removed bool                 `json:"removed"`
+	Usage   []api.NamespaceUsage `json:"usage,omitempty"`
+}
+
+// NewReportResource returns the report of a resource GVK deprecated by target release
+func NewReportResource(resource string, namespaced bool, deprecation Deprecation, target Version) ReportResource {
+	reportResource := ReportResource{
+		Resource:     resource,
+		Namespaced:   namespaced,
+		GVK:          deprecation.GroupVersionKind,
+		DeprecatedIn: deprecation.DeprecatedIn,
+		RemovedIn:    deprecation.RemovedIn,
+		Removed:      deprecation.IsRemovedIn(target),
+	}
+	if !deprecation.Replacement.Empty() {
+		replacement := deprecation.Replacement
+		reportResource.Replacement = &replacement
+	}
+	return reportResource
+}
+
+// Message tells when the GVK is deprecated and removed and what replaces it
+func (r ReportResource) Message() string {
+	message := []string{
+		fmt.Sprintf("deprecated in %s", r.DeprecatedIn),
+		fmt.Sprintf("removed in %s", r.RemovedIn),
+	}
+	if r.Replacement != nil {
+		message = append(message, fmt.Sprintf("replace with %s", finding.GVK(*r.Replacement)))
+	} else {
+		message = append(message, "no replacement")
+	}
+	return strings.Join(message, ", ")
+}
+
+// Findings flattens deprecation report, each object of a deprecated resource is a finding
+func (r ReportDeprecation) Findings() []finding.Finding {
+	findings := []finding.Finding{}
+	for _, resource := range r.Resources {
+		category := deprecatedCategory
+		if resource.Removed {
+			category = removedCategory
+		}
+
+		scope := finding.ClusterScope
+		if resource.Namespaced {
+			scope = finding.NamespacedScope
+		}
+
+		resourceFinding := finding.Finding{
+			Section:    SectionName,
+			Category:   category,
+			Scope:      scope,
+			Resource:   resource.Resource,
+			Group:      api.GroupKey(resource.GVK.Group),
+			Source:     finding.GVK(resource.GVK),
+			Confidence: categoryConfidence[category],
+			Message:    resource.Message(),
+		}
+		if resource.Replacement != nil {
+			resourceFinding.Destination = finding.GVK(*resource.Replacement)
+		}
+
+		if len(resource.Usage) == 0 {
+			findings = append(findings, resourceFinding)
+			continue
+		}
+
+		for _, usage := range resource.Usage {
+			for _, object := range usage.Objects {
+				objectFinding := resourceFinding
+				objectFinding.Namespaces = []string{usage.Namespace}
+				objectFinding.Object = object
+				findings = append(findings, objectFinding)
+			}
+		}
+	}
+	return findings
+}
@@ -0,0 +1,83 @@
+package deprecation
+
+import (
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestLookup(t *testing.T) {
+	ingress, ok := Lookup(schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"})
+	require.True(t, ok)
+
+	assert.False(t, ingress.IsDeprecatedIn(Version{Major: 1, Minor: 13}))
+	assert.True(t, ingress.IsDeprecatedIn(Version{Major: 1, Minor: 16}))
+	assert.False(t, ingress.IsRemovedIn(Version{Major: 1, Minor: 16}))
+	assert.True(t, ingress.IsRemovedIn(Version{Major: 1, Minor: 22}))
+
+	_, ok = Lookup(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	assert.False(t, ok)
+}
+
+func TestFindings(t *testing.T) {
+	target := Version{Major: 1, Minor: 16}
+	ingress, _ := Lookup(schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"})
+	psp, _ := Lookup(schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "PodSecurityPolicy"})
+
+	ingresses := NewReportResource("ingresses", true, ingress, target)
+	ingresses.Usage = []api.NamespaceUsage{{Namespace: "app1", Count: 2, Objects: []string{"api", "web"}}}
+
+	report := ReportDeprecation{
+		TargetVersion: target,
+		Resources: []ReportResource{
+			ingresses,
+			NewReportResource("podsecuritypolicies", false, psp, target),
+		},
+	}
+
+	expected := []finding.Finding{
+		{
+			Section:     SectionName,
+			Category:    deprecatedCategory,
+			Scope:       finding.NamespacedScope,
+			Resource:    "ingresses",
+			Group:       "extensions",
+			Namespaces:  []string{"app1"},
+			Object:      "api",
+			Source:      "extensions/v1beta1 Ingress",
+			Destination: "networking.k8s.io/v1 Ingress",
+			Confidence:  api.ModerateConfidence,
+			Message:     "deprecated in 1.14, removed in 1.22, replace with networking.k8s.io/v1 Ingress",
+		},
+		{
+			Section:     SectionName,
+			Category:    deprecatedCategory,
+			Scope:       finding.NamespacedScope,
+			Resource:    "ingresses",
+			Group:       "extensions",
+			Namespaces:  []string{"app1"},
+			Object:      "web",
+			Source:      "extensions/v1beta1 Ingress",
+			Destination: "networking.k8s.io/v1 Ingress",
+			Confidence:  api.ModerateConfidence,
+			Message:     "deprecated in 1.14, removed in 1.22, replace with networking.k8s.io/v1 Ingress",
+		},
+		{
+			Section:     SectionName,
+			Category:    removedCategory,
+			Scope:       finding.ClusterScope,
+			Resource:    "podsecuritypolicies",
+			Group:       "extensions",
+			Source:      "extensions/v1beta1 PodSecurityPolicy",
+			Destination: "policy/v1beta1 PodSecurityPolicy",
+			Confidence:  api.NoConfidence,
+			Message:     "deprecated in 1.11, removed in 1.16, replace with policy/v1beta1 PodSecurityPolicy",
+		},
+	}
+	assert.Equal(t, expected, report.Findings())
+}
@@ -0,0 +1,133 @@
+package deprecation
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Deprecation is a GVK deprecated by a Kubernetes release and no longer served from a later one
+type Deprecation struct {
+	GroupVersionKind schema.GroupVersionKind
+	DeprecatedIn     Version
+	// RemovedIn is the first release not serving the GVK
+	RemovedIn Version
+	// Replacement is the GVK objects should be converted to, it's empty when the API is removed without replacement
+	Replacement schema.GroupVersionKind
+}
+
+// Deprecations is the table of deprecated Kubernetes APIs, OpenShift releases serve the ones of the Kubernetes release they ship.
+// See https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var Deprecations = []Deprecation{
+	// Removed in 1.16
+	deprecated("extensions/v1beta1", "DaemonSet", "1.9", "1.16", "apps/v1"),
+	deprecated("extensions/v1beta1", "Deployment", "1.9", "1.16", "apps/v1"),
+	deprecated("extensions/v1beta1", "ReplicaSet", "1.9", "1.16", "apps/v1"),
+	deprecated("extensions/v1beta1", "NetworkPolicy", "1.9", "1.16", "networking.k8s.io/v1"),
+	deprecated("extensions/v1beta1", "PodSecurityPolicy", "1.11", "1.16", "policy/v1beta1"),
+	deprecated("apps/v1beta1", "Deployment", "1.9", "1.16", "apps/v1"),
+	deprecated("apps/v1beta1", "StatefulSet", "1.9", "1.16", "apps/v1"),
+	deprecated("apps/v1beta2", "DaemonSet", "1.9", "1.16", "apps/v1"),
+	deprecated("apps/v1beta2", "Deployment", "1.9", "1.16", "apps/v1"),
+	deprecated("apps/v1beta2", "ReplicaSet", "1.9", "1.16", "apps/v1"),
+	deprecated("apps/v1beta2", "StatefulSet", "1.9", "1.16", "apps/v1"),
+
+	// Removed in 1.21
+	deprecated("batch/v2alpha1", "CronJob", "1.8", "1.21", "batch/v1"),
+
+	// Removed in 1.22
+	deprecated("admissionregistration.k8s.io/v1beta1", "MutatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1"),
+	deprecated("admissionregistration.k8s.io/v1beta1", "ValidatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1"),
+	deprecated("apiextensions.k8s.io/v1beta1", "CustomResourceDefinition", "1.16", "1.22", "apiextensions.k8s.io/v1"),
+	deprecated("apiregistration.k8s.io/v1beta1", "APIService", "1.19", "1.22", "apiregistration.k8s.io/v1"),
+	deprecated("authentication.k8s.io/v1beta1", "TokenReview", "1.19", "1.22", "authentication.k8s.io/v1"),
+	deprecated("authorization.k8s.io/v1beta1", "LocalSubjectAccessReview", "1.19", "1.22", "authorization.k8s.io/v1"),
+	deprecated("authorization.k8s.io/v1beta1", "SelfSubjectAccessReview", "1.19", "1.22", "authorization.k8s.io/v1"),
+	deprecated("authorization.k8s.io/v1beta1", "SubjectAccessReview", "1.19", "1.22", "authorization.k8s.io/v1"),
+	deprecated("certificates.k8s.io/v1beta1", "CertificateSigningRequest", "1.19", "1.22", "certificates.k8s.io/v1"),
+	deprecated("coordination.k8s.io/v1beta1", "Lease", "1.19", "1.22", "coordination.k8s.io/v1"),
+	deprecated("extensions/v1beta1", "Ingress", "1.14", "1.22", "networking.k8s.io/v1"),
+	deprecated("networking.k8s.io/v1beta1", "Ingress", "1.19", "1.22", "networking.k8s.io/v1"),
+	deprecated("networking.k8s.io/v1beta1", "IngressClass", "1.19", "1.22", "networking.k8s.io/v1"),
+	deprecated("rbac.authorization.k8s.io/v1beta1", "ClusterRole", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecated("rbac.authorization.k8s.io/v1beta1", "ClusterRoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecated("rbac.authorization.k8s.io/v1beta1", "Role", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecated("rbac.authorization.k8s.io/v1beta1", "RoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecated("scheduling.k8s.io/v1beta1", "PriorityClass", "1.14", "1.22", "scheduling.k8s.io/v1"),
+	deprecated("storage.k8s.io/v1beta1", "CSIDriver", "1.19", "1.22", "storage.k8s.io/v1"),
+	deprecated("storage.k8s.io/v1beta1", "CSINode", "1.17", "1.22", "storage.k8s.io/v1"),
+	deprecated("storage.k8s.io/v1beta1", "StorageClass", "1.19", "1.22", "storage.k8s.io/v1"),
+	deprecated("storage.k8s.io/v1beta1", "VolumeAttachment", "1.19", "1.22", "storage.k8s.io/v1"),
+
+	// Removed in 1.25
+	deprecated("batch/v1beta1", "CronJob", "1.21", "1.25", "batch/v1"),
+	deprecated("discovery.k8s.io/v1beta1", "EndpointSlice", "1.21", "1.25", "discovery.k8s.io/v1"),
+	deprecated("events.k8s.io/v1beta1", "Event", "1.19", "1.25", "events.k8s.io/v1"),
+	deprecated("autoscaling/v2beta1", "HorizontalPodAutoscaler", "1.22", "1.25", "autoscaling/v2"),
+	deprecated("policy/v1beta1", "PodDisruptionBudget", "1.21", "1.25", "policy/v1"),
+	deprecated("policy/v1beta1", "PodSecurityPolicy", "1.21", "1.25", ""),
+	deprecated("node.k8s.io/v1beta1", "RuntimeClass", "1.20", "1.25", "node.k8s.io/v1"),
+
+	// Removed in 1.26
+	deprecated("flowcontrol.apiserver.k8s.io/v1beta1", "FlowSchema", "1.23", "1.26", "flowcontrol.apiserver.k8s.io/v1beta3"),
+	deprecated("flowcontrol.apiserver.k8s.io/v1beta1", "PriorityLevelConfiguration", "1.23", "1.26", "flowcontrol.apiserver.k8s.io/v1beta3"),
+	deprecated("autoscaling/v2beta2", "HorizontalPodAutoscaler", "1.23", "1.26", "autoscaling/v2"),
+
+	// Removed in 1.27
+	deprecated("storage.k8s.io/v1beta1", "CSIStorageCapacity", "1.24", "1.27", "storage.k8s.io/v1"),
+
+	// Removed in 1.29
+	deprecated("flowcontrol.apiserver.k8s.io/v1beta2", "FlowSchema", "1.26", "1.29", "flowcontrol.apiserver.k8s.io/v1beta3"),
+	deprecated("flowcontrol.apiserver.k8s.io/v1beta2", "PriorityLevelConfiguration", "1.26", "1.29", "flowcontrol.apiserver.k8s.io/v1beta3"),
+
+	// Removed in 1.32
+	deprecated("flowcontrol.apiserver.k8s.io/v1beta3", "FlowSchema", "1.29", "1.32", "flowcontrol.apiserver.k8s.io/v1"),
+	deprecated("flowcontrol.apiserver.k8s.io/v1beta3", "PriorityLevelConfiguration", "1.29", "1.32", "flowcontrol.apiserver.k8s.io/v1"),
+}
+
+// deprecated builds a table entry, the replacement has the same kind in replacementGroupVersion
+func deprecated(groupVersion, kind, deprecatedIn, removedIn, replacementGroupVersion string) Deprecation {
+	deprecation := Deprecation{
+		GroupVersionKind: mustParseGroupVersion(groupVersion).WithKind(kind),
+		DeprecatedIn:     mustParseVersion(deprecatedIn),
+		RemovedIn:        mustParseVersion(removedIn),
+	}
+	if replacementGroupVersion != "" {
+		deprecation.Replacement = mustParseGroupVersion(replacementGroupVersion).WithKind(kind)
+	}
+	return deprecation
+}
+
+func mustParseGroupVersion(groupVersion string) schema.GroupVersion {
+	gv, err := schema.ParseGroupVersion(groupVersion)
+	if err != nil {
+		panic(err)
+	}
+	return gv
+}
+
+func mustParseVersion(version string) Version {
+	v, err := ParseVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Lookup returns the deprecation of a GVK, false when it isn't deprecated by any release
+func Lookup(gvk schema.GroupVersionKind) (Deprecation, bool) {
+	for _, deprecation := range Deprecations {
+		if deprecation.GroupVersionKind == gvk {
+			return deprecation, true
+		}
+	}
+	return Deprecation{}, false
+}
+
+// IsDeprecatedIn returns true when the GVK is deprecated or removed by target release
+func (d Deprecation) IsDeprecatedIn(target Version) bool {
+	return target.AtLeast(d.DeprecatedIn)
+}
+
+// IsRemovedIn returns true when the GVK isn't served by target release
+func (d Deprecation) IsRemovedIn(target Version) bool {
+	return target.AtLeast(d.RemovedIn)
+}
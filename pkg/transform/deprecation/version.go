@@ -0,0 +1,91 @@
+package deprecation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Version is a Kubernetes minor release
+type Version struct {
+	Major int
+	Minor int
+}
+
+// openShift3Minimum is the first OpenShift 3 release whose minor version matches Kubernetes one
+const openShift3Minimum = 6
+
+// openShift4Offset is the difference between Kubernetes and OpenShift 4 minor versions, OpenShift 4.x ships Kubernetes 1.(x+13)
+const openShift4Offset = 13
+
+// ParseVersion parses a Kubernetes version such as "1.16", "v1.16.2" or "v1.11.0+d4cacc0",
+// or an OpenShift version such as "3.11" or "4.6" which is converted to the Kubernetes version it ships
+func ParseVersion(version string) (Version, error) {
+	fields := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".", 3)
+	if len(fields) < 2 {
+		return Version{}, errors.Errorf("version %q must be <major>.<minor>", version)
+	}
+
+	major, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return Version{}, errors.Errorf("version %q has an invalid major version", version)
+	}
+
+	// Some providers suffix the minor version of their releases, such as "16+"
+	minor, err := strconv.Atoi(strings.TrimRight(fields[1], "+"))
+	if err != nil {
+		return Version{}, errors.Errorf("version %q has an invalid minor version", version)
+	}
+
+	switch {
+	case major == 1:
+		return Version{Major: 1, Minor: minor}, nil
+	case major == 3 && minor >= openShift3Minimum:
+		return Version{Major: 1, Minor: minor}, nil
+	case major == 4:
+		return Version{Major: 1, Minor: minor + openShift4Offset}, nil
+	}
+	return Version{}, errors.Errorf("version %q is neither a Kubernetes 1.x, OpenShift 3.%d+ nor OpenShift 4.x version", version, openShift3Minimum)
+}
+
+// IsZero returns true when the version isn't set
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// AtLeast returns true when v is the same or a later release than other
+func (v Version) AtLeast(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor >= other.Minor
+}
+
+func (v Version) String() string {
+	if v.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// MarshalText writes the version as "<major>.<minor>"
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText reads a version written by MarshalText
+func (v *Version) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*v = Version{}
+		return nil
+	}
+
+	version, err := ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = version
+	return nil
+}
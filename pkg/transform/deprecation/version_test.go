@@ -0,0 +1,45 @@
+package deprecation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		name          string
+		version       string
+		expected      Version
+		expectedError string
+	}{
+		{name: "kubernetes", version: "1.16", expected: Version{Major: 1, Minor: 16}},
+		{name: "kubernetes git version", version: "v1.11.0+d4cacc0", expected: Version{Major: 1, Minor: 11}},
+		{name: "provider minor suffix", version: "v1.18+", expected: Version{Major: 1, Minor: 18}},
+		{name: "openshift 3", version: "3.11", expected: Version{Major: 1, Minor: 11}},
+		{name: "openshift 4", version: "4.6", expected: Version{Major: 1, Minor: 19}},
+		{name: "openshift 4 patch", version: "4.9.12", expected: Version{Major: 1, Minor: 22}},
+		{name: "missing minor", version: "4", expectedError: `version "4" must be <major>.<minor>`},
+		{name: "invalid minor", version: "1.x", expectedError: `version "1.x" has an invalid minor version`},
+		{name: "old openshift", version: "3.5", expectedError: `version "3.5" is neither a Kubernetes 1.x, OpenShift 3.6+ nor OpenShift 4.x version`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := ParseVersion(tc.version)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	assert.True(t, Version{Major: 1, Minor: 16}.AtLeast(Version{Major: 1, Minor: 16}))
+	assert.True(t, Version{Major: 1, Minor: 22}.AtLeast(Version{Major: 1, Minor: 16}))
+	assert.False(t, Version{Major: 1, Minor: 9}.AtLeast(Version{Major: 1, Minor: 16}))
+}
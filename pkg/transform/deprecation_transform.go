@@ -0,0 +1,138 @@
+package transform
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/deprecation"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DeprecationTransformName is the deprecation report name
+const DeprecationTransformName = "Deprecation"
+
+// DeprecationExtraction holds source cluster APIs deprecated by the target release
+type DeprecationExtraction struct {
+	deprecation.ReportDeprecation
+}
+
+// DeprecationTransform looks for source cluster APIs deprecated or removed by the target release
+type DeprecationTransform struct {
+	Session *api.Session
+	// TargetVersion is the Kubernetes or OpenShift release to upgrade or migrate to, defaults to destination cluster version
+	TargetVersion string
+}
+
+// Transform converts the retrieved information to a useful output
+func (e DeprecationExtraction) Transform() ([]reportoutput.Section, error) {
+	logrus.Info("DeprecationTransform::Transform:Reports")
+	return []reportoutput.Section{{Name: deprecation.SectionName, Content: e.ReportDeprecation}}, nil
+}
+
+// Validate no need to validate it, data is exctracted from API
+func (e DeprecationExtraction) Validate() (err error) { return }
+
+// Extract collects source GVKs deprecated by the target release and their objects in namespaces to analyse
+func (e DeprecationTransform) Extract(ctx context.Context) (Extraction, error) {
+	target, err := e.targetVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	srcSnapshot, err := e.Session.Source.Discover()
+	if err != nil {
+		return nil, err
+	}
+
+	extraction := &DeprecationExtraction{}
+	extraction.ClusterName = e.Session.Source.Name
+	extraction.TargetVersion = target
+	if e.TargetVersion == "" {
+		extraction.TargetProfile = e.Session.Destination.Profile
+	}
+	if srcSnapshot.ServerVersion != "" {
+		if extraction.SourceVersion, err = deprecation.ParseVersion(srcSnapshot.ServerVersion); err != nil {
+			logrus.Warnf("Source cluster version unknown: %s", err)
+		}
+	}
+
+	lister := usageLister{source: e.Session.Source, addError: extraction.addError}
+	extraction.Namespaces = lister.sessionNamespaces(e.Session)
+
+	for _, resourceList := range srcSnapshot.Resources {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			extraction.addError(err)
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			// Subresources are served with their resource
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+
+			found, ok := deprecation.Lookup(gv.WithKind(resource.Kind))
+			if !ok || !found.IsDeprecatedIn(target) {
+				continue
+			}
+
+			reportResource := deprecation.NewReportResource(resource.Name, resource.Namespaced, found, target)
+			if resource.Namespaced {
+				reportResource.Usage = lister.usageOf(ctx, extraction.Namespaces, resource.Name, found.GroupVersionKind)
+			}
+			extraction.Resources = append(extraction.Resources, reportResource)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(extraction.Resources, func(i, j int) bool {
+		a, b := extraction.Resources[i], extraction.Resources[j]
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		return a.GVK.GroupVersion().String() < b.GVK.GroupVersion().String()
+	})
+
+	return *extraction, nil
+}
+
+// targetVersion returns the release to look for deprecations in, the destination cluster one when none is set
+func (e DeprecationTransform) targetVersion() (deprecation.Version, error) {
+	if e.TargetVersion != "" {
+		return deprecation.ParseVersion(e.TargetVersion)
+	}
+
+	if e.Session.Destination == nil {
+		return deprecation.Version{}, errors.New("target version is required without destination cluster")
+	}
+
+	dstSnapshot, err := e.Session.Destination.Discover()
+	if err != nil {
+		return deprecation.Version{}, err
+	}
+
+	if dstSnapshot.ServerVersion == "" {
+		return deprecation.Version{}, errors.Errorf("Kubernetes version of %s is unknown, set a target version", e.Session.Destination.Name)
+	}
+	return deprecation.ParseVersion(dstSnapshot.ServerVersion)
+}
+
+func (e *DeprecationExtraction) addError(err error) {
+	logrus.Warn(err)
+	e.Errors = append(e.Errors, err.Error())
+}
+
+// Name returns a human readable name for the transform
+func (e DeprecationTransform) Name() string {
+	return DeprecationTransformName
+}
@@ -0,0 +1,55 @@
+package transform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/deprecation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecationTransform(t *testing.T) {
+	testCases := []struct {
+		name          string
+		destination   bool
+		targetVersion string
+		expected      []string
+		expectedError string
+	}{
+		{name: "destination version", destination: true, expected: []string{"deprecated batch/v2alpha1", "removed apps/v1beta1", "deprecated extensions/v1beta1"}},
+		{name: "target version", targetVersion: "1.13", expected: []string{"deprecated batch/v2alpha1", "deprecated apps/v1beta1"}},
+		{name: "openshift target version", destination: true, targetVersion: "4.8", expected: []string{"removed batch/v2alpha1", "removed apps/v1beta1", "deprecated extensions/v1beta1"}},
+		{name: "missing target version", expectedError: "target version is required without destination cluster"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			session := &api.Session{Mode: "Differential", Source: loadSnapshotCluster(t, "testdata/snapshot-src.json")}
+			if tc.destination {
+				session.Destination = loadSnapshotCluster(t, "testdata/snapshot-dst.json")
+			}
+
+			extraction, err := DeprecationTransform{Session: session, TargetVersion: tc.targetVersion}.Extract(context.Background())
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			report := extraction.(DeprecationExtraction).ReportDeprecation
+			assert.Equal(t, deprecation.Version{Major: 1, Minor: 11}, report.SourceVersion)
+			resources := []string{}
+			for _, resource := range report.Resources {
+				status := "deprecated"
+				if resource.Removed {
+					status = "removed"
+				}
+				resources = append(resources, status+" "+resource.GVK.GroupVersion().String())
+			}
+			assert.Equal(t, tc.expected, resources)
+			assert.Empty(t, report.Errors)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package finding
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// NamespacedScope is the scope of findings about namespaced resources
+	NamespacedScope = "Namespaced"
+	// ClusterScope is the scope of findings about cluster-scoped resources
+	ClusterScope = "Cluster"
+)
+
+// Finding is a report item flattened for tabular output formats
+type Finding struct {
+	Section     string         `json:"section,omitempty"`
+	Category    string         `json:"category"`
+	Scope       string         `json:"scope,omitempty"`
+	Resource    string         `json:"resource,omitempty"`
+	Group       string         `json:"group,omitempty"`
+	Namespaces  []string       `json:"namespaces,omitempty"`
+	Object      string         `json:"object,omitempty"`
+	Source      string         `json:"source,omitempty"`
+	Destination string         `json:"destination,omitempty"`
+	Confidence  api.Confidence `json:"confidence"`
+	Message     string         `json:"message,omitempty"`
+}
+
+// Finder is implemented by reports which can be flattened into findings
+type Finder interface {
+	Findings() []Finding
+}
+
+// GVKs formats GVKs as a comma separated list of "group/version Kind"
+func GVKs(gvks []schema.GroupVersionKind) string {
+	list := make([]string, 0, len(gvks))
+	for _, gvk := range gvks {
+		list = append(list, GVK(gvk))
+	}
+	return strings.Join(list, ", ")
+}
+
+// GVK formats a GVK as "group/version Kind", the core group being omitted
+func GVK(gvk schema.GroupVersionKind) string {
+	return fmt.Sprintf("%s %s", gvk.GroupVersion().String(), gvk.Kind)
+}
@@ -0,0 +1,130 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/transform/finding"
+	"github.com/pkg/errors"
+)
+
+// Prefix is the file name prefix of plugin executables
+const Prefix = "phronetic-check-"
+
+// SectionPrefix prefixes report section names of plugins
+const SectionPrefix = "plugin-"
+
+// Plugin is an external check executable.
+// It reads an Input as JSON on stdin and writes an Output as JSON on stdout,
+// a non-zero exit status fails the check.
+type Plugin struct {
+	// Name is the executable file name without Prefix
+	Name string
+	Path string
+}
+
+// Input is the run context written to plugins stdin
+type Input struct {
+	// Mode is the operational mode: Migration, Differential or Verification
+	Mode string `json:"mode"`
+	// Namespaces are the source namespaces to analyse
+	Namespaces []string `json:"namespaces,omitempty"`
+	MigPlan    string   `json:"migPlan,omitempty"`
+	// KubeConfig is the path of the kubeconfig file holding the contexts
+	KubeConfig         string `json:"kubeconfig,omitempty"`
+	SourceContext      string `json:"sourceContext,omitempty"`
+	DestinationContext string `json:"destinationContext,omitempty"`
+	// OutputDir is the directory reports are written into, plugins can write their own files there
+	OutputDir string `json:"outputDir,omitempty"`
+}
+
+// Output is read from plugins stdout, findings without confidence have NoConfidence
+type Output struct {
+	Findings []finding.Finding `json:"findings"`
+}
+
+// ReportPlugin represents json report of a plugin
+type ReportPlugin struct {
+	Plugin  string            `json:"plugin"`
+	Results []finding.Finding `json:"findings"`
+}
+
+// Discover returns plugins found in dirs, a plugin name is taken by the first directory having it
+func Discover(dirs []string) []Plugin {
+	plugins := []Plugin{}
+	found := map[string]bool{}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, file := range files {
+			name := strings.TrimPrefix(file.Name(), Prefix)
+			if name == file.Name() || name == "" || found[name] || file.IsDir() || file.Mode()&0111 == 0 {
+				continue
+			}
+			found[name] = true
+			plugins = append(plugins, Plugin{Name: name, Path: filepath.Join(dir, file.Name())})
+		}
+	}
+	return plugins
+}
+
+// SearchPath returns the directories plugins are searched in: dir if any, then PATH directories
+func SearchPath(dir string) []string {
+	return append([]string{dir}, filepath.SplitList(os.Getenv("PATH"))...)
+}
+
+// Run runs the plugin with input and returns its report, the plugin is killed once ctx is done
+func (p Plugin) Run(ctx context.Context, input Input) (ReportPlugin, error) {
+	stdin, err := json.Marshal(input)
+	if err != nil {
+		return ReportPlugin{}, errors.Wrap(err, "unable to marshal plugin input")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return ReportPlugin{}, errors.Wrapf(err, "plugin %s failed: %s", p.Name, message)
+		}
+		return ReportPlugin{}, errors.Wrapf(err, "plugin %s failed", p.Name)
+	}
+
+	output := Output{}
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return ReportPlugin{}, errors.Wrapf(err, "unable to read plugin %s output", p.Name)
+	}
+
+	return ReportPlugin{Plugin: p.Name, Results: output.Findings}, nil
+}
+
+// SectionName returns the report section name of the plugin
+func (r ReportPlugin) SectionName() string {
+	return SectionPrefix + r.Plugin
+}
+
+// Findings returns the plugin findings, their section is the plugin report section
+func (r ReportPlugin) Findings() []finding.Finding {
+	findings := make([]finding.Finding, 0, len(r.Results))
+	for _, f := range r.Results {
+		f.Section = r.SectionName()
+		findings = append(findings, f)
+	}
+	return findings
+}
@@ -0,0 +1,97 @@
+//go:build !windows
+// +build !windows
+
+package plugin
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscover(t *testing.T) {
+	dir1, dir2 := tempDir(t), tempDir(t)
+	defer os.RemoveAll(dir1)
+	defer os.RemoveAll(dir2)
+
+	writeScript(t, dir1, "phronetic-check-labels", 0755, "")
+	writeScript(t, dir1, "phronetic-check-readme", 0644, "")
+	writeScript(t, dir1, "kubectl-labels", 0755, "")
+	writeScript(t, dir2, "phronetic-check-labels", 0755, "")
+	writeScript(t, dir2, "phronetic-check-annotations", 0755, "")
+
+	plugins := Discover([]string{"", dir1, filepath.Join(dir1, "missing"), dir2})
+	assert.Equal(t, []Plugin{
+		{Name: "labels", Path: filepath.Join(dir1, "phronetic-check-labels")},
+		{Name: "annotations", Path: filepath.Join(dir2, "phronetic-check-annotations")},
+	}, plugins)
+}
+
+func TestRun(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	testCases := []struct {
+		name          string
+		script        string
+		expected      ReportPlugin
+		expectedError string
+	}{
+		{
+			name: "findings",
+			script: `grep -q '"mode":"Migration"' || exit 1
+echo '{"findings": [{"category": "Missing label", "resource": "deployments", "namespaces": ["app1"], "confidence": "Moderate"}]}'`,
+			expected: ReportPlugin{
+				Plugin: "labels",
+				Results: []finding.Finding{
+					{Category: "Missing label", Resource: "deployments", Namespaces: []string{"app1"}, Confidence: api.ModerateConfidence},
+				},
+			},
+		},
+		{
+			name:          "failure",
+			script:        "echo 'cannot reach cluster' >&2; exit 3",
+			expectedError: "plugin labels failed: cannot reach cluster: exit status 3",
+		},
+		{
+			name:          "invalid output",
+			script:        "echo done",
+			expectedError: "unable to read plugin labels output: invalid character 'd' looking for beginning of value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			writeScript(t, dir, "phronetic-check-labels", 0755, tc.script)
+			plugin := Plugin{Name: "labels", Path: filepath.Join(dir, "phronetic-check-labels")}
+
+			report, err := plugin.Run(context.Background(), Input{Mode: "Migration"})
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, report)
+			assert.Equal(t, "plugin-labels", report.Findings()[0].Section)
+		})
+	}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "phronetic-plugins")
+	require.NoError(t, err)
+	return dir
+}
+
+func writeScript(t *testing.T, dir, name string, mode os.FileMode, script string) {
+	file := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(file, []byte("#!/bin/sh\n"+script+"\n"), mode))
+	require.NoError(t, os.Chmod(file, mode))
+}
@@ -0,0 +1,96 @@
+package transform
+
+import (
+	"context"
+
+	"github.com/gildub/phronetic/pkg/transform/plugin"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/sirupsen/logrus"
+)
+
+// PluginExtraction holds the report of a plugin run
+type PluginExtraction struct {
+	Report plugin.ReportPlugin
+}
+
+// PluginTransform represents transform running an external check plugin
+type PluginTransform struct {
+	Plugin plugin.Plugin
+	Config CheckConfig
+}
+
+// Transform converts the retrieved information to a useful output
+func (e PluginExtraction) Transform() ([]reportoutput.Section, error) {
+	logrus.Infof("PluginTransform::Transform:Reports %s", e.Report.Plugin)
+
+	return []reportoutput.Section{
+		{Name: e.Report.SectionName(), Content: e.Report},
+	}, nil
+}
+
+// Validate no need to validate it, findings are provided by the plugin
+func (e PluginExtraction) Validate() (err error) { return }
+
+// Extract runs the plugin with the run context
+func (e PluginTransform) Extract(ctx context.Context) (Extraction, error) {
+	report, err := e.Plugin.Run(ctx, e.input())
+	if err != nil {
+		return nil, err
+	}
+
+	return PluginExtraction{Report: report}, nil
+}
+
+func (e PluginTransform) input() plugin.Input {
+	session := e.Config.Session
+	input := plugin.Input{
+		Mode:       session.Mode,
+		KubeConfig: e.Config.KubeConfigPath,
+		OutputDir:  e.Config.OutputDir,
+	}
+
+	if session.Source != nil {
+		input.SourceContext = session.Source.Context
+	}
+	if session.Destination != nil {
+		input.DestinationContext = session.Destination.Context
+	}
+	if session.MigPlan != nil {
+		input.MigPlan = session.MigPlan.Name
+		input.Namespaces = session.MigPlan.GetSourceNamespaces()
+	}
+	return input
+}
+
+// Name returns a human readable name for the transform
+func (e PluginTransform) Name() string {
+	return plugin.Prefix + e.Plugin.Name
+}
+
+// RegisterPlugins registers a check for each plugin found in dir and in PATH directories.
+// Plugins are disabled by default and only run when enabled with --enable,
+// a plugin whose name is already registered is ignored.
+func RegisterPlugins(dir string) {
+	registered := map[string]bool{}
+	for _, check := range Checks() {
+		registered[check.Name] = true
+	}
+
+	for _, p := range plugin.Discover(plugin.SearchPath(dir)) {
+		if registered[p.Name] {
+			logrus.Warnf("Ignoring plugin %s, check %s is already registered", p.Path, p.Name)
+			continue
+		}
+
+		p := p
+		Register(Check{
+			Name:        p.Name,
+			Description: "External check " + p.Path,
+			Disabled:    true,
+			Sections:    map[string]interface{}{plugin.ReportPlugin{Plugin: p.Name}.SectionName(): plugin.ReportPlugin{}},
+			New: func(config CheckConfig) Transform {
+				return PluginTransform{Plugin: p, Config: config}
+			},
+		})
+	}
+}
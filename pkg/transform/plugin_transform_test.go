@@ -0,0 +1,36 @@
+//go:build !windows
+// +build !windows
+
+package transform
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterPlugins(t *testing.T) {
+	registered := checks
+	defer func() { checks = registered }()
+
+	dir, err := ioutil.TempDir("", "phronetic-plugins")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "phronetic-check-labels"), []byte("#!/bin/sh\n"), 0755))
+
+	RegisterPlugins(dir)
+
+	selected, err := SelectChecks("Migration", nil, nil)
+	require.NoError(t, err)
+	for _, check := range selected {
+		assert.NotEqual(t, "labels", check.Name)
+	}
+
+	selected, err = SelectChecks("Migration", []string{"labels"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "labels", selected[len(selected)-1].Name)
+}
@@ -0,0 +1,204 @@
+package transform
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/transform/cluster"
+	"github.com/gildub/phronetic/pkg/transform/crd"
+	"github.com/gildub/phronetic/pkg/transform/deprecation"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/gildub/phronetic/pkg/transform/verification"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// Check is a registered transform which can be enabled or disabled by name
+type Check struct {
+	// Name identifies the check with --enable and --disable
+	Name        string
+	Description string
+	// Modes are the operational modes the check runs in, it runs in all modes when empty
+	Modes []string
+	// Disabled checks only run when enabled
+	Disabled bool
+	// RequiresDestination checks are skipped when there is no destination cluster
+	RequiresDestination bool
+	// RequiresLiveClusters checks are skipped when a cluster is a snapshot or a reference profile
+	RequiresLiveClusters bool
+	// Sections maps names of the sections generated by the check to their content type,
+	// it's used to decode them when a report is read
+	Sections map[string]interface{}
+	// New creates the transform of an analysis
+	New func(config CheckConfig) Transform
+}
+
+// CheckConfig holds what checks are created with
+type CheckConfig struct {
+	Session *api.Session
+	// Rules are custom normalization rules used in Verification mode
+	Rules verification.Rules
+	// TargetVersion is the Kubernetes or OpenShift release deprecated APIs are looked for
+	TargetVersion string
+	// KubeConfigPath and OutputDir are passed to plugins
+	KubeConfigPath string
+	OutputDir      string
+}
+
+var (
+	checksMutex sync.RWMutex
+	checks      []Check
+)
+
+func init() {
+	Register(Check{
+		Name:                "cluster",
+		Description:         "Compares API resources served by source and destination clusters",
+		Modes:               []string{"Migration", "Differential"},
+		RequiresDestination: true,
+		Sections: map[string]interface{}{
+			cluster.MigOperatorSectionName: cluster.ReportMigOperator{},
+			cluster.DiffSectionName:        cluster.ReportDiff{},
+		},
+		New: func(config CheckConfig) Transform {
+			return ClusterTransform{Session: config.Session}
+		},
+	})
+
+	Register(Check{
+		Name:        "deprecation",
+		Description: "Flags source cluster APIs deprecated or removed by the target version or the destination cluster version",
+		Modes:       []string{"Migration", "Differential"},
+		Sections:    map[string]interface{}{deprecation.SectionName: deprecation.ReportDeprecation{}},
+		New: func(config CheckConfig) Transform {
+			return DeprecationTransform{Session: config.Session, TargetVersion: config.TargetVersion}
+		},
+	})
+
+	Register(Check{
+		Name:                 "crd",
+		Description:          "Compares schemas of CRDs defined on both clusters and validates source objects against destination schemas",
+		Modes:                []string{"Migration", "Differential"},
+		RequiresDestination:  true,
+		RequiresLiveClusters: true,
+		Sections:             map[string]interface{}{crd.SectionName: crd.ReportCRD{}},
+		New: func(config CheckConfig) Transform {
+			return CRDTransform{Session: config.Session}
+		},
+	})
+
+	Register(Check{
+		Name:        "verification",
+		Description: "Compares objects of MigPlan namespaces on source and destination clusters after a migration",
+		Modes:       []string{"Verification"},
+		Sections:    map[string]interface{}{verification.SectionName: verification.ReportVerification{}},
+		New: func(config CheckConfig) Transform {
+			return VerificationTransform{Session: config.Session, Rules: config.Rules}
+		},
+	})
+
+	env.AddValidator(validateChecks)
+}
+
+// Register makes a check available, checks run in registration order.
+// Packages providing checks register them from their init function,
+// a program importing them along with github.com/gildub/phronetic/cmd runs them from the command line.
+// Types of the check sections are registered with the report output.
+// Register panics when the name is empty or already registered.
+func Register(check Check) {
+	checksMutex.Lock()
+	defer checksMutex.Unlock()
+
+	if check.Name == "" || check.New == nil {
+		panic("transform: Register check without name or New function")
+	}
+
+	for _, registered := range checks {
+		if registered.Name == check.Name {
+			panic(fmt.Sprintf("transform: Register called twice for check %s", check.Name))
+		}
+	}
+	checks = append(checks, check)
+
+	for name, content := range check.Sections {
+		reportoutput.RegisterSectionType(name, content)
+	}
+}
+
+// Checks returns registered checks
+func Checks() []Check {
+	checksMutex.RLock()
+	defer checksMutex.RUnlock()
+
+	return append([]Check{}, checks...)
+}
+
+// RunsIn returns true when the check runs in mode
+func (c Check) RunsIn(mode string) bool {
+	if len(c.Modes) == 0 {
+		return true
+	}
+	return contains(c.Modes, mode)
+}
+
+// SelectChecks returns the checks to run in mode: checks enabled by default or in enable, unless in disable
+func SelectChecks(mode string, enable, disable []string) ([]Check, error) {
+	registered := Checks()
+
+	known := map[string]Check{}
+	for _, check := range registered {
+		known[check.Name] = check
+	}
+
+	for _, name := range append(append([]string{}, enable...), disable...) {
+		if _, ok := known[name]; !ok {
+			return nil, errors.Errorf("Unknown check %q, run \"phronetic checks list\" for available checks", name)
+		}
+	}
+
+	for _, name := range enable {
+		if contains(disable, name) {
+			return nil, errors.Errorf("Check %s can't be both enabled and disabled", name)
+		}
+		if !known[name].RunsIn(mode) {
+			return nil, errors.Errorf("Check %s doesn't run in %s mode", name, mode)
+		}
+	}
+
+	selected := []Check{}
+	for _, check := range registered {
+		enabled := (!check.Disabled && check.RunsIn(mode)) || contains(enable, check.Name)
+		if enabled && !contains(disable, check.Name) {
+			selected = append(selected, check)
+		}
+	}
+	return selected, nil
+}
+
+// validateChecks registers plugins and checks --enable and --disable name checks of the configured mode,
+// so that a wrong name fails before clusters are queried
+func validateChecks(config *viper.Viper) []string {
+	RegisterPlugins(config.GetString("PluginDir"))
+
+	// An invalid mode is reported on its own
+	mode := config.GetString("Mode")
+	if !contains(env.Modes, mode) {
+		return nil
+	}
+
+	if _, err := SelectChecks(mode, config.GetStringSlice("Enable"), config.GetStringSlice("Disable")); err != nil {
+		return []string{err.Error()}
+	}
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package transform
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/gildub/phronetic/pkg/transform/verification"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectChecks(t *testing.T) {
+	registered := checks
+	defer func() { checks = registered }()
+
+	Register(Check{Name: "labels", Disabled: true, New: func(config CheckConfig) Transform { return nil }})
+	Register(Check{Name: "annotations", New: func(config CheckConfig) Transform { return nil }})
+
+	testCases := []struct {
+		name          string
+		mode          string
+		enable        []string
+		disable       []string
+		expected      []string
+		expectedError string
+	}{
+		{name: "default Migration checks", mode: "Migration", expected: []string{"cluster", "deprecation", "crd", "annotations"}},
+		{name: "default Verification checks", mode: "Verification", expected: []string{"verification", "annotations"}},
+		{name: "enabled check", mode: "Differential", enable: []string{"labels"}, expected: []string{"cluster", "deprecation", "crd", "labels", "annotations"}},
+		{name: "disabled check", mode: "Migration", disable: []string{"cluster"}, expected: []string{"deprecation", "crd", "annotations"}},
+		{name: "unknown check", mode: "Migration", disable: []string{"unknown"}, expectedError: `Unknown check "unknown", run "phronetic checks list" for available checks`},
+		{name: "check of another mode", mode: "Migration", enable: []string{"verification"}, expectedError: "Check verification doesn't run in Migration mode"},
+		{name: "enabled and disabled check", mode: "Migration", enable: []string{"labels"}, disable: []string{"labels"}, expectedError: "Check labels can't be both enabled and disabled"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected, err := SelectChecks(tc.mode, tc.enable, tc.disable)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, check := range selected {
+				names = append(names, check.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestValidateChecks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   map[string]interface{}
+		expected []string
+	}{
+		{name: "default checks", values: map[string]interface{}{"Mode": "Differential"}},
+		{name: "invalid mode", values: map[string]interface{}{"Mode": "Other", "Enable": []string{"unknown"}}},
+		{
+			name:     "unknown check",
+			values:   map[string]interface{}{"Mode": "Migration", "Disable": []string{"unknown"}},
+			expected: []string{`Unknown check "unknown", run "phronetic checks list" for available checks`},
+		},
+		{
+			name:     "check of another mode",
+			values:   map[string]interface{}{"Mode": "Verification", "Enable": []string{"crd"}},
+			expected: []string{"Check crd doesn't run in Verification mode"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := viper.New()
+			for key, value := range tc.values {
+				config.Set(key, value)
+			}
+			assert.Equal(t, tc.expected, validateChecks(config))
+		})
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	assert.Panics(t, func() {
+		Register(Check{Name: "cluster", New: func(config CheckConfig) Transform { return nil }})
+	})
+}
+
+func TestRegisterSectionTypes(t *testing.T) {
+	report := reportoutput.ReportOutput{}
+	require.NoError(t, json.Unmarshal([]byte(`{"verification": {"migPlan": "plan1"}, "custom": {"count": 1}}`), &report))
+
+	assert.Equal(t, verification.ReportVerification{MigPlan: "plan1"}, report.Section(verification.SectionName))
+	assert.Equal(t, json.RawMessage(`{"count": 1}`), report.Section("custom"))
+}
@@ -18,6 +18,5 @@ func (r Report) Flush() error {
 // ReportOutputFlush flush reports to disk
 var ReportOutputFlush = func(r Report) error {
 	logrus.Info("Flushing reports to disk")
-	reportoutput.DumpReports(r.Report)
-	return nil
+	return reportoutput.DumpReports(r.Report)
 }
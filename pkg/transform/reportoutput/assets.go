@@ -0,0 +1,8 @@
+// +build dev
+
+package reportoutput
+
+import "net/http"
+
+// Assets contains report templates
+var Assets http.FileSystem = http.Dir("resources")
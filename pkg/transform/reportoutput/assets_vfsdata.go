@@ -0,0 +1,186 @@
+// Code generated by vfsgen; DO NOT EDIT.
+
+// +build !dev
+
+package reportoutput
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	pathpkg "path"
+	"time"
+)
+
+// Assets statically implements the virtual filesystem provided to vfsgen.
+var Assets = func() http.FileSystem {
+	fs := vfsgen۰FS{
+		"/": &vfsgen۰DirInfo{
+			name:    "/",
+			modTime: time.Date(2026, 10, 17, 6, 56, 8, 368522961, time.UTC),
+		},
+		"/report.html": &vfsgen۰CompressedFileInfo{
+			name:             "report.html",
+			modTime:          time.Date(2026, 10, 17, 9, 21, 1, 511255890, time.UTC),
+			uncompressedSize: 15800,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\xe9\x6f\xdb\x46\x16\xff\xee\xbf\xe2\xad\x6a\xec\x6e\x01\x5b\xca\xd1\x14\x85\xcc\x08\x28\xec\xd4\x9b\xcd\xa6\x31\xec\xc4\xc0\x7e\x1c\x93\x23\x71\x6a\x8a\x43\xcc\x8c\xdc\x08\x82\xfe\xf7\xc5\xdc\x07\x0f\x49\xb6\xdb\x14\x58\xe4\x43\xc4\x39\xde\xbc\xfb\xfd\xe6\x91\xde\x6c\x4e\xa1\xc0\x73\x52\x63\x18\x2d\x1e\xee\xf9\x08\x4e\xb7\xdb\xa3\x4c\xa0\xbb\x0a\xcf\x8e\x00\x32\x51\x62\x54\xcc\x32\xc1\x66\x99\x28\x67\x97\x8c\xae\x9a\x6c\x22\x4a\xf5\x74\x8b\x19\x27\xb4\x76\xcf\x1f\x48\x5d\xe8\x87\x89\x5c\x3f\xd1\x7b\x15\x95\x3b\x5a\xac\x25\x3d\x79\x1e\x43\xf5\x02\xc3\x18\xb6\xdb\x23\x00\x39\x29\x69\x17\xb3\xcd\x06\xc8\x1c\xc6\xea\x08\xd8\x6e\x37\x9b\xe8\x37\xae\x38\x86\xed\x36\xa7\x0c\xcb\xa7\xba\x80\xed\x36\x9b\x88\xc2\x6e\x1d\x1b\x66\xd2\xe1\x0f\x24\x58\x2a\xd9\x32\x4c\x60\x35\x2c\x79\x9b\x18\xe6\xb2\x89\x91\xda\x4e\x4b\x4d\x1c\x85\x0a\x62\x5e\x43\x5e\x8e\x63\x86\x39\x5d\xb1\x1c\x9f\xc0\xf1\x42\xf2\xce\x61\xfa\x56\x4b\x97\x15\x58\x20\x52\x71\xc8\x2b\xc4\xf9\xdb\x91\x5d\x39\x92\x9a\xc8\xf8\x6a\xb9\x44\x6c\x2d\xe5\x76\x34\x14\xa7\x76\x22\x52\x97\xa6\x2d\xcf\x78\xb8\x57\x27\xd8\xc3\x94\x16\xb3\xf2\x8d\xa2\xb3\x30\x0a\xcb\x26\xe5\x1b\x79\xc8\x66\x03\x02\x2f\x9b\x0a\x09\x67\x5f\x4d\x60\xbb\x8d\xf5\x90\x4d\x0c\xaf\x5e\xfc\xed\xb6\x5f\x13\xab\x9a\x63\xf6\x80\x8b\x1d\xee\x72\x6d\xc4\x72\x1e\x12\xfb\xcf\x15\xc3\x73\xcc\x18\x2e\xe0\xe1\x29\x9e\x34\x60\x81\x3e\x0d\xb6\x14\x18\xb9\x61\x6c\x0e\xef\x4c\x81\x7a\xc3\xc1\x87\xfb\x1e\xe7\x53\x53\x3b\x1d\xf0\xd1\xde\x88\x2b\x9a\x23\x61\x8d\xe0\x05\x3d\xcc\xf7\xc6\xd6\x48\xbf\xa2\xa5\x14\x18\xfe\xce\x10\x63\x67\x20\x83\xe7\x33\x62\x0b\x2c\x5c\x18\x4e\x82\x31\x2f\x71\xb8\xd2\x08\x0b\x19\x6f\x50\x6d\x8f\xce\x69\x3d\x27\x05\xae\x73\x7c\x2a\x97\x9e\xbb\x47\xd8\x6e\x47\xb3\xd6\x50\x36\x91\x9b\x67\x51\x1c\x64\x05\x79\xf0\xe4\xaa\xd5\xb2\xe6\x2a\x88\xf4\xcc\x4c\xba\xff\x8d\xf1\x34\x1d\x09\xa9\xd7\x8f\x6f\xbc\x41\xe5\x8e\x78\xef\x05\xe6\x82\xd4\x48\xa8\x64\xd6\x43\x20\x58\x13\x52\x31\x3f\x0e\x8c\x9f\xbc\x5a\x71\x81\xd9\x4d\x4e\x9b\xd0\x7e\x32\xff\xdd\xb0\xfc\x53\x5d\xad\xaf\x2f\xa5\x67\x66\xe5\x0f\x46\x30\xa0\x75\xb5\x06\x6b\x45\x9e\x4d\xca\x1f\x62\x36\x4d\x72\x8a\xf7\xbb\x54\x19\x92\xbf\x44\xcd\xe5\xed\x87\x84\xfc\x02\x35\x20\x47\x07\x09\xfb\x9d\x6d\xc2\x17\x5c\xc4\x84\x43\x85\xed\xa6\x1e\x6d\x6f\x53\xff\x62\x12\x4e\x9b\xf1\x26\x4d\x22\x1c\x6a\x2a\x40\x2f\x87\xbb\x35\x14\x9e\x8f\xf6\xf1\x3e\x91\xa5\x47\xb4\x79\xb8\xb6\x01\x67\x18\xf0\xcf\x43\x66\xb1\x8b\x46\x31\x81\x36\x79\xa9\xdf\xdb\xeb\x44\xba\xfd\x0c\x6e\xf6\xb5\x69\x4a\xad\xde\x5e\x77\x59\x64\x0f\xc2\x7e\xf3\x66\xb3\xaf\x47\xff\xa9\xbe\x1c\xfb\xdb\xe5\x4e\x1f\xfb\x3f\x77\x30\x57\x6e\x4d\xee\xe6\x61\x9d\x88\xca\x42\xab\x30\xf3\x38\x1b\xbb\x7a\x7f\x08\x40\x4c\x2a\x79\x58\xcb\x3b\xcb\xb4\x39\x5a\x96\xea\x6e\xd6\x01\xd2\xba\xdd\x59\x9f\x2d\x9d\xa4\x06\x27\x85\x37\x28\xbd\xea\xa7\x96\xb0\x3f\xad\x4b\x7b\x99\xe8\xd0\xa6\xda\xe9\xcc\x66\xf5\x1e\xb1\xb4\xe2\x68\x81\x77\x40\x2b\x59\xad\x79\x83\x02\x6c\xf5\xe9\xee\x37\x9c\x0b\xee\x9e\xd5\x8a\x27\xc1\xf1\xb1\x3b\x24\xd1\xe9\xf8\x9c\xae\x6a\x91\x0c\x1a\xf3\x91\x13\x38\xae\x25\x94\x90\x76\x33\x3c\x69\xb1\xc9\x1c\x8e\x09\x6c\xb7\x27\xe0\x74\x20\x8d\xa6\x16\x07\x7a\xe9\x47\x4a\x07\x80\xa3\xda\x72\xfe\xc5\xeb\x32\xf0\xb1\x7e\xc4\xd8\xe1\x88\xca\x1c\x09\x62\x94\x36\x4f\x90\xe2\x38\xf6\xc0\xf2\x87\xd9\x51\xe4\x07\xc6\xaa\xc7\xea\xff\xd0\xfe\xdd\x3f\x5b\x22\x31\x8c\x0a\x52\x63\x6e\xef\x68\xc6\x33\x81\x36\xb8\x8e\xa2\xf7\xda\x2e\x9c\x3e\x3b\x0e\xb3\x9e\xff\x0b\xa9\x0b\x52\x2f\x94\x1b\x98\x58\xcc\x9a\x99\x19\xe5\x53\xf0\xee\xe0\x0f\x3d\x81\xe3\x5c\xae\x97\x9a\x6c\x11\x18\x60\xf4\x38\x6f\x31\x7a\x9c\x77\x30\x2a\x0f\x35\x27\x68\x50\x6a\xdd\xa9\x69\x39\x92\x15\xc3\xf9\x77\x22\x88\x1b\xdf\x47\x94\x36\x91\x6f\x2c\x8c\x95\xa3\x3f\x4b\xb7\x93\x87\xb7\xbc\x1b\x0a\x52\xda\x01\xe9\xbb\xc5\x46\x57\x4e\x09\x32\xc7\xa3\x9d\xb3\x33\xf1\xb8\x70\x94\x86\x71\x02\x0c\xa6\x9f\x20\x82\xbb\x53\xd0\x5e\x85\x22\x5a\xd4\x51\x35\x5a\xd1\x8c\x19\xa3\xcc\x84\xb2\xb5\x20\x0c\x85\x75\x87\xa2\x7e\xa5\x35\x1e\xcd\xde\x29\x4a\xf0\xcf\xcd\x06\x2a\x5c\x2b\x2a\xdf\x9f\x00\xc3\x0d\x65\x02\x08\x87\x06\x31\x41\x50\x15\x57\xf0\x55\x35\x3b\x8a\xcc\xe6\x84\xab\x88\xd4\xa9\xa4\x92\x4d\x2a\x92\x48\x26\x25\x5e\x55\x43\x75\xb1\x53\xd8\x82\xf0\x9c\x3e\x60\xb6\x7e\xd7\x96\x9a\x32\x77\x39\x1b\x87\x00\xf5\x40\x5d\x7c\xa4\x05\x66\x48\xe0\xd1\xec\xc2\x1e\x06\x5a\xc7\x27\x1e\x3d\x01\x9d\x83\x28\x31\xc7\xa0\xf3\xb4\x81\x06\x1c\x10\xc3\xb0\x24\x9c\x93\x7a\x01\x73\x46\x97\x72\x95\x51\x61\xac\xb7\xfe\x98\x3a\xd7\x00\xd8\x85\xcf\x65\x78\x82\x1b\x55\x0a\x38\x2c\xa0\x8c\x72\x5a\xc1\x64\x2f\xbc\x3e\x10\xf4\x45\xdd\x40\xa4\x24\x48\xc6\x1f\x31\x37\x85\x67\xd8\xbb\x83\x93\x13\x73\xc4\xc7\x07\x93\xcf\xce\x43\x57\x84\x1d\xe8\x72\x54\x23\x8f\x1d\x28\xea\xe7\xab\xf7\x03\xbd\x27\x93\x29\x3b\xcd\xe5\x8d\x15\x28\xcc\xf1\x6f\x74\x24\xf5\xfe\xf3\xd5\xfb\x1e\x6d\x44\x9d\xa1\xae\xec\x68\xd4\x13\x88\x1b\xa8\xc6\x29\xa6\x57\x05\x1c\xe7\xf2\x4e\x32\x59\x92\xc5\xa7\x46\x06\x07\x35\xd7\xb3\xcc\xcc\x48\xee\xb3\xf2\xd5\xec\x23\x59\x30\x65\x48\x55\xed\xc6\xc6\x93\x1d\x2b\xe5\xab\x56\x2b\xd1\x66\xaf\xb1\x49\x3e\xdb\x6d\xba\xa2\x15\xf2\x63\x1f\x97\xed\xd5\x01\xb6\x19\x3b\xf8\x12\x97\xb7\x30\x9b\x4b\xbe\xd3\xdc\xe0\xb3\xc3\xec\x4b\xcd\x57\x8d\x4c\x7f\xf1\xcd\x29\x88\xe3\xc8\x6a\x09\xe5\x80\xb6\x49\x33\x96\x86\x69\x39\xed\xe8\x9f\x0d\x54\xff\x47\x61\x2e\xcb\xad\x56\x02\xe2\xba\xcd\xd8\x98\xb3\xed\xf3\x44\x0d\x38\x37\x09\x37\x7d\x31\x31\xd7\x85\x46\xdd\x64\xb0\x47\xb5\xda\x23\x44\xf1\x1f\xc2\x25\x0e\xe9\x45\x46\x0a\xf3\xf3\x18\x0d\x99\x3d\x03\xb8\x3f\xba\x0d\x75\x09\x90\x35\xed\x16\x31\x9f\x82\xa9\xd7\x52\xfe\x1b\x96\xa7\x57\x43\x75\x50\x70\x25\x97\x87\x8e\x2f\xb8\xe8\x58\x67\xd0\x94\x6d\x05\x76\x37\x18\x9f\xda\x64\x8c\xf6\x3f\xa1\xd1\xa8\xe8\xf8\x87\x20\x1d\x3a\xbb\x59\xdd\x45\x73\xd6\x99\x93\x18\xf1\x86\xec\x0d\x8c\xbb\xb5\x5b\xf4\xe4\x20\xf1\x94\x92\x37\x1c\x6a\xa5\x84\x14\x00\xbb\xc2\x32\x84\x27\x49\xd0\x59\xa8\x62\x49\xc4\x6e\x34\x59\x55\x07\x2a\x2d\x9a\xb1\x71\xe4\x64\x08\x62\xa6\x47\xbb\xf6\xde\x7b\x57\xd1\xfc\x5e\x82\x0a\x89\x27\x96\x36\xcd\xb6\x34\x1e\x38\x41\x7a\x71\xed\x3e\xb5\x97\xd7\xa1\xb4\xe8\x28\x0d\x67\xc5\x3f\xb0\x71\x17\x1e\xf1\x1c\xcd\xbb\x90\xde\xb7\x6c\xe0\x85\x7c\xfc\x41\x4d\xbc\xc4\xe8\x3d\x7e\x67\x4a\xf7\x29\x57\x2f\x16\x06\xcc\x1c\x9c\x9d\xbc\x8b\xb0\xe5\x5f\xbf\x9b\x68\x1d\x9d\x4d\x1c\x78\xd8\x09\x3c\x0a\x32\x9f\x63\x86\x6b\x79\xfd\xe8\x46\x1e\x17\xc1\x0a\x0d\x3e\xae\x15\xe8\xbe\x61\xb9\x61\x23\x41\x23\x30\x09\x56\x5d\x70\xd1\xbd\xca\x63\x96\x53\xf8\x9d\x88\xb2\x63\xc3\x15\xa3\x73\x52\xd9\x78\x6a\xa2\xde\x38\xe1\x0a\xff\x9b\x6b\x10\xa8\xc2\xa1\x0a\x74\xa3\x37\x9d\x28\xff\x41\xc0\x30\xaa\xc0\xa8\x6f\xdc\x7d\x35\xff\xb6\x98\xc9\x45\x3c\x8f\xb3\x43\xe2\x35\xa6\x6c\x19\xc8\x0c\xa4\x86\xba\xbf\xcc\xdb\x99\xb8\xda\xf3\xe1\x52\xef\x36\x05\x2e\xdd\x93\x7a\xba\xf2\xdd\x63\x72\xa4\x31\x45\xc5\xdd\xb3\x84\x2f\xb4\xc6\xe6\x12\xe8\x83\x03\x7e\x2f\x49\x5e\x42\x8e\xea\x7f\x08\xb8\xb3\x79\x1a\x17\xda\x75\x4a\xb2\x28\xc1\xc3\x38\x28\xd1\x43\xa4\x2a\x7d\x9f\xf4\x0a\xb3\x8e\x90\xb8\x42\x14\xbd\xf1\xcc\x40\xca\x36\x96\x31\x3e\xb6\x57\x84\xec\x11\xea\xa3\x36\x91\x0e\x26\x7b\x9c\x25\x0c\x14\xf3\xc9\xc6\x40\x70\x99\x88\x09\x3e\xe0\xd0\x4b\x9c\x17\x84\x32\x05\x14\x1e\x2f\x93\x27\xb2\xbf\x4c\x7f\xad\xb4\xf9\x80\x19\x99\x93\x5c\xa9\xb8\x3b\x6d\xde\x06\x2b\xb4\x02\x3f\x92\xc5\x55\x85\x24\x60\x54\x8d\xa0\xb1\xb7\x6c\x9c\x38\xbd\x76\xcc\xf8\xf7\x87\xdc\xef\x1c\x36\xeb\x4b\x2c\x5d\x3e\x1c\x5c\x6b\x15\xaf\xaa\x49\xf5\xd1\x34\x5b\xb6\x5b\xdb\x77\x39\x71\x73\xef\xbe\x0a\x86\x24\xcb\x58\xfe\xf0\xe3\xe7\xa5\x4c\x43\x52\xb7\x90\xeb\x9f\x2d\xfb\xe8\xb4\xe7\x89\xcb\x02\x6c\x9f\x68\x3d\x5c\xe8\x4d\x4c\x8f\xc2\xfd\xce\x4d\x23\xfa\x96\x41\x49\x5d\xff\xde\x9f\xb6\xdd\xdb\x4d\xd9\x8b\xa8\x0e\x94\x07\x98\x21\x45\xf3\x28\x81\xc8\xe9\xf2\x83\x20\x79\xd4\x98\x80\xa0\x21\x01\x81\xc5\x12\x0d\xc7\x1d\xb0\x8e\x2e\x98\x6e\x34\x84\xed\x94\x2b\x24\x4a\xf7\x70\x8b\xaa\x55\x77\x3f\xc5\x52\xb3\x5d\x95\xd6\x75\xe0\x0a\x89\xbc\xb4\x92\x02\xa4\x6d\x16\x77\x70\xda\x51\x91\xe7\x87\x63\x59\xc3\xb0\x92\x5f\xf1\xa2\x66\xe4\x48\xd2\x90\xb2\x87\x07\x36\x4a\x1a\x53\xe6\xd1\x6b\x23\x4a\x34\xad\xdd\xd1\x63\xb4\x34\x98\x39\x08\x5c\xe1\x86\xe1\xa1\x24\x71\x61\x16\xe0\x02\x7e\xbe\x7a\xaf\xef\xeb\x49\xad\x30\xf9\x5b\x57\x19\xe3\x0c\xe3\x8f\xe8\x37\xca\x7c\x22\x09\xe7\x64\xbe\x70\x8e\xdb\xfe\x50\xc8\xaf\x6a\x03\x30\xbd\x22\x45\x5d\x7a\xd4\xe2\x72\x0b\xbc\x82\x22\xbd\x03\x83\xd1\xf9\x73\xc1\xb0\x1e\xc0\xd4\xb8\xab\x5c\x45\xe9\x3d\x2e\x60\x4e\xd9\x9f\x07\x90\xba\x44\x51\x8c\x4a\xfc\x99\x5e\x90\x15\xbc\x91\xa6\x0e\x6e\x36\xa6\x55\x62\x94\x23\xf5\x5b\x78\xaf\x20\x75\xa7\xed\x7a\x54\xe8\x02\x31\x3d\x76\x38\xe9\x44\x29\xc7\x6e\xb5\x49\xe6\xf2\xf6\x43\xfc\x71\x99\x1c\xf0\x9c\xb8\x35\x41\x62\x92\x56\xba\xc6\x4b\x2a\x05\xec\x7f\xd3\xa6\xdf\x8c\x30\xbd\xce\x34\xd5\x3c\x06\xe9\xdb\xe5\xdf\x21\x78\x2d\x05\x9b\xbb\x50\x6b\xd6\xcc\x2e\x5a\x1a\xf5\x23\xef\xa5\x18\xf2\x05\x84\x62\xc4\xce\x1b\xfe\xcd\xe4\x66\xe3\xef\x28\x15\xca\xf1\x12\xab\x37\x98\x4c\x3f\xe9\x39\xd7\x58\xb7\x8a\xea\xce\xdc\x5e\xc4\x9a\x82\x21\x20\xc9\xa5\x2e\xe5\x1d\xde\x02\xe6\xc1\xde\xe0\x66\xf3\xbc\xa9\x2b\x67\x45\x77\xca\x3a\x5f\x71\x41\x97\x0e\x7f\x01\xcf\x4b\xbc\x44\x26\x75\xed\x0d\x69\x0e\x43\x34\x3d\x61\x9f\xdc\x89\x1e\x50\x45\x0a\x6b\xe3\xbf\x42\xf0\x5f\xc8\xbb\x36\x11\xfe\x5b\x18\xc9\xb3\x56\x57\x98\x3b\xcf\xe9\xb2\x41\xb2\xf5\xb1\xdd\x82\xd6\xae\x8d\xc1\x90\x80\x36\x50\x01\xb4\x86\x3b\x2a\x4a\x9b\x4c\xf5\x7b\xb1\x5c\x92\x10\xe4\xae\xc2\xbb\x32\x43\x8b\xa7\x60\xce\x38\xec\x23\x92\xc6\x23\x93\xc4\xfb\x5a\xd9\xcc\xa6\xef\x5d\xb9\xc2\x82\xcc\xd6\x36\x20\x7a\xc4\xba\xc2\x23\xb2\x89\x76\xe3\x00\xb4\x0e\x66\x94\x08\x60\x25\xf0\xea\x17\x82\x2b\xff\x72\x4a\xc3\x3f\xf7\x68\x5c\x56\xac\x1b\x3f\x16\xde\xd6\xfc\x44\x07\xf6\x8a\x91\x57\x60\x38\x7d\x88\xb1\x5b\x1b\x77\xa5\xf0\x2a\xc8\x46\xd1\x98\x66\xee\xf3\xba\xc1\xe9\x4c\xc0\x62\x34\x1d\x60\xb1\xc8\xe1\x5a\x38\x2c\x42\x61\x36\xa4\x5b\x76\x74\x80\xda\xcc\x00\x8f\x22\xdc\xe3\xeb\x41\x03\xb8\x90\x76\x1a\xf6\x6f\x08\x25\xbe\x25\xb4\x52\x92\xf0\xc3\x15\xdd\xcd\x71\xaa\x6f\xc7\x40\xaa\x46\x97\xfc\xfc\x58\x90\x98\x1e\x2c\x63\xaa\x6d\xe3\xd9\x1c\x4c\x4c\x7e\x53\x94\x98\x9e\x66\x9c\x9d\x95\xe4\xe0\xb2\x32\x37\x9f\x1d\xdd\xe0\xbc\x1f\x0d\x47\x2a\xd2\xf5\x21\x34\x74\x62\xe6\x73\x24\xf0\x82\xb2\xb5\x33\xac\xcd\x9a\x6e\xc0\xd9\x81\x27\x1f\xe0\xb9\x47\xff\x62\xcf\x0d\x99\xf7\xdf\x3d\xbe\x11\x7a\x46\xe0\x17\xf6\xab\xaa\x40\xc1\x81\x3f\x58\x4e\x13\xd3\x3b\x70\xd8\xef\x12\x35\x3f\xa8\x48\xf1\xb6\x13\x98\xa3\xb4\xdc\xc1\xe8\xa3\x5f\x7e\x3e\xdb\xa7\x02\xbb\x9d\x66\x81\x6b\xcc\x48\x7e\xb0\xcf\x98\xdb\xa3\xa0\xff\xbe\xf9\xf4\xab\x12\x40\x60\xf3\x29\x64\xc3\x06\xce\xce\xfe\x76\xf1\xe9\xfc\xf3\x7f\xaf\xde\x41\x29\x96\xf2\x0b\x1a\xf9\x1f\x54\xa8\x5e\xbc\x1d\xe1\x7a\x24\x07\x8c\x23\x64\x4b\x2c\x54\xb1\x60\x1c\x8b\xb7\xa3\x95\x98\x9f\xfe\xa4\xde\x3c\x67\x82\x88\x0a\xcf\xae\x4a\x46\x6b\x2c\x48\xee\x3e\x50\xd1\xe3\x72\x05\x17\x6b\xeb\xd1\x52\x2b\xb0\x81\x39\xad\xc5\xe9\x1c\x2d\x49\xb5\x9e\x02\x47\x35\x3f\xe5\xb2\x79\x74\x06\x4b\xc4\x16\xa4\x9e\xc2\x2b\xbc\x3c\x83\x9c\x56\x94\x4d\xe1\xbb\xd7\xaf\x5f\x9f\x81\x76\xb3\xf2\x25\x6c\xe0\x8e\xb2\x02\xb3\xd3\x3b\x2a\x04\x5d\x4e\xe1\x55\xf3\x15\x38\x95\xc9\xf3\xbb\xfc\xc5\x0b\xbb\x52\x69\xdd\x2f\xce\x69\x55\xa1\x86\xe3\x29\xd8\x5f\xfe\xb0\x17\xe3\x37\x78\x09\x7e\x67\x79\x02\xa2\x70\x5b\xa7\xf0\x32\x38\x20\xcf\xcf\xa0\x41\x85\x0c\x6d\xb9\xf1\x95\xdc\x38\xfe\x51\x72\x2b\xf0\x57\x71\x8a\x2a\xb2\xa8\xa7\x50\xe1\xb9\xf0\xe4\x24\x29\x94\xdf\xcb\xaf\x7b\xea\x62\x0a\xdf\x61\x8c\xed\xa4\x45\x1c\x9b\x80\x99\xd7\x92\xa6\xf9\xff\x25\x5e\xa6\x4b\x67\x60\x2a\x33\x6c\x20\x5f\x31\x2e\x55\xd4\x50\x52\x0b\xcc\xce\xb4\x62\x7f\xc7\x64\x51\x8a\x29\xdc\xd1\xaa\x48\x76\x8f\x1d\x8a\x0d\xc9\x44\xbb\x6a\xca\x96\xa8\x3a\x8b\x6d\xb4\xa4\x35\x55\xf1\x68\xe9\x8d\xcd\xfb\x68\xd8\x40\x41\x78\x53\xa1\xf5\x14\xe6\x15\xfe\x7a\x06\x0b\xd4\x18\xfb\xe9\x95\x0d\xc3\xa9\x02\xe6\x3f\xca\x7f\x91\x22\xdf\xf8\x0d\xe3\x20\x42\x25\x0c\x82\x8d\xf3\x04\x65\xdf\x5e\x19\xc7\x1d\x38\x27\xd8\x7c\xf7\xe3\xbe\x9b\xff\x45\x16\x65\xb0\xf1\xc5\x4f\x83\x1b\xb9\x40\x62\xc5\x4f\xe7\x88\x54\xb8\xd8\x9f\x59\xb3\xcd\x7c\x08\xb7\x3f\x9f\x66\x9f\x04\xc0\x15\x16\x78\x4f\x3e\xb3\x89\x89\xc2\x6c\xa2\x6a\xca\x51\x26\x43\x51\x06\xf8\xcb\x8e\xd8\x2d\x5f\xce\xec\x97\x70\xe3\x1b\x75\x9e\xcc\xf3\x12\xcc\xab\x87\xe4\x13\x5e\xc3\xd1\x66\x13\x2c\x1e\xcd\xa2\x47\x03\x2f\x15\x54\x4f\x3e\x94\x52\x57\xc9\xa0\x39\x7f\xf4\x6c\xef\xbd\x92\x83\xc8\x3c\xbc\x67\xb5\x9a\xc3\x16\xde\x77\xd4\x09\xe9\x85\xa3\xd9\x2f\xda\xc0\x82\xa1\x9a\xcf\x29\x5b\x06\x5f\x37\x3a\xb2\xdf\x9f\xc8\x06\x11\x61\x60\xf2\xed\xb3\x7c\xc4\xf7\xd9\x9e\xe8\x4a\xf6\x55\x89\x38\x7e\xca\xe7\x7b\x5e\x0f\xed\xe2\xed\x8e\x4b\xab\x9f\x3a\xf5\x79\x4b\xa2\x31\x42\xa7\xad\x6e\xee\x49\x63\xde\x5a\x58\x63\x85\x76\x9a\xd9\xf9\x2e\x83\xf8\xbd\xce\x22\x79\x89\xf3\x7b\x0e\x05\x95\x2f\xd4\x50\xd3\x54\x6b\x10\x54\xce\x01\xaa\x51\xb5\xe6\xb8\x70\x37\xcc\xc7\x1b\x46\x7f\x0a\x75\x98\x2d\x3c\xaf\x87\x18\x23\xf8\xc8\xea\x79\xd4\x1e\xfc\x91\xc1\xd8\x00\x10\x89\xae\xe4\x9f\x0b\x18\x67\xf6\x7f\x8b\x60\xb6\x64\x13\xfb\xd5\x5f\x29\x96\xd5\xec\xe8\x7f\x03\x00\x2f\xdd\x92\x72\xb8\x3d\x00\x00"),
+		},
+	}
+	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/report.html"].(os.FileInfo),
+	}
+
+	return fs
+}()
+
+type vfsgen۰FS map[string]interface{}
+
+func (fs vfsgen۰FS) Open(path string) (http.File, error) {
+	path = pathpkg.Clean("/" + path)
+	f, ok := fs[path]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	switch f := f.(type) {
+	case *vfsgen۰CompressedFileInfo:
+		gr, err := gzip.NewReader(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			// This should never happen because we generate the gzip bytes such that they are always valid.
+			panic("unexpected error reading own gzip compressed bytes: " + err.Error())
+		}
+		return &vfsgen۰CompressedFile{
+			vfsgen۰CompressedFileInfo: f,
+			gr:                        gr,
+		}, nil
+	case *vfsgen۰DirInfo:
+		return &vfsgen۰Dir{
+			vfsgen۰DirInfo: f,
+		}, nil
+	default:
+		// This should never happen because we generate only the above types.
+		panic(fmt.Sprintf("unexpected type %T", f))
+	}
+}
+
+// vfsgen۰CompressedFileInfo is a static definition of a gzip compressed file.
+type vfsgen۰CompressedFileInfo struct {
+	name              string
+	modTime           time.Time
+	compressedContent []byte
+	uncompressedSize  int64
+}
+
+func (f *vfsgen۰CompressedFileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰CompressedFileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰CompressedFileInfo) GzipBytes() []byte {
+	return f.compressedContent
+}
+
+func (f *vfsgen۰CompressedFileInfo) Name() string       { return f.name }
+func (f *vfsgen۰CompressedFileInfo) Size() int64        { return f.uncompressedSize }
+func (f *vfsgen۰CompressedFileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰CompressedFileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰CompressedFileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰CompressedFileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰CompressedFile is an opened compressedFile instance.
+type vfsgen۰CompressedFile struct {
+	*vfsgen۰CompressedFileInfo
+	gr      *gzip.Reader
+	grPos   int64 // Actual gr uncompressed position.
+	seekPos int64 // Seek uncompressed position.
+}
+
+func (f *vfsgen۰CompressedFile) Read(p []byte) (n int, err error) {
+	if f.grPos > f.seekPos {
+		// Rewind to beginning.
+		err = f.gr.Reset(bytes.NewReader(f.compressedContent))
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = 0
+	}
+	if f.grPos < f.seekPos {
+		// Fast-forward.
+		_, err = io.CopyN(ioutil.Discard, f.gr, f.seekPos-f.grPos)
+		if err != nil {
+			return 0, err
+		}
+		f.grPos = f.seekPos
+	}
+	n, err = f.gr.Read(p)
+	f.grPos += int64(n)
+	f.seekPos = f.grPos
+	return n, err
+}
+func (f *vfsgen۰CompressedFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.seekPos = 0 + offset
+	case io.SeekCurrent:
+		f.seekPos += offset
+	case io.SeekEnd:
+		f.seekPos = f.uncompressedSize + offset
+	default:
+		panic(fmt.Errorf("invalid whence value: %v", whence))
+	}
+	return f.seekPos, nil
+}
+func (f *vfsgen۰CompressedFile) Close() error {
+	return f.gr.Close()
+}
+
+// vfsgen۰DirInfo is a static definition of a directory.
+type vfsgen۰DirInfo struct {
+	name    string
+	modTime time.Time
+	entries []os.FileInfo
+}
+
+func (d *vfsgen۰DirInfo) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("cannot Read from directory %s", d.name)
+}
+func (d *vfsgen۰DirInfo) Close() error               { return nil }
+func (d *vfsgen۰DirInfo) Stat() (os.FileInfo, error) { return d, nil }
+
+func (d *vfsgen۰DirInfo) Name() string       { return d.name }
+func (d *vfsgen۰DirInfo) Size() int64        { return 0 }
+func (d *vfsgen۰DirInfo) Mode() os.FileMode  { return 0755 | os.ModeDir }
+func (d *vfsgen۰DirInfo) ModTime() time.Time { return d.modTime }
+func (d *vfsgen۰DirInfo) IsDir() bool        { return true }
+func (d *vfsgen۰DirInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰Dir is an opened dir instance.
+type vfsgen۰Dir struct {
+	*vfsgen۰DirInfo
+	pos int // Position within entries for Seek and Readdir.
+}
+
+func (d *vfsgen۰Dir) Seek(offset int64, whence int) (int64, error) {
+	if offset == 0 && whence == io.SeekStart {
+		d.pos = 0
+		return 0, nil
+	}
+	return 0, fmt.Errorf("unsupported Seek in directory %s", d.name)
+}
+
+func (d *vfsgen۰Dir) Readdir(count int) ([]os.FileInfo, error) {
+	if d.pos >= len(d.entries) && count > 0 {
+		return nil, io.EOF
+	}
+	if count <= 0 || count > len(d.entries)-d.pos {
+		count = len(d.entries) - d.pos
+	}
+	e := d.entries[d.pos : d.pos+count]
+	d.pos += count
+	return e, nil
+}
@@ -0,0 +1,34 @@
+package reportoutput
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+)
+
+var csvHeader = []string{"Section", "Category", "Scope", "Resource", "Group", "Namespaces", "Object", "Source", "Destination", "Confidence", "Message", "Profile"}
+
+type csvWriter struct{}
+
+func (w csvWriter) Render(r ReportOutput) ([]byte, error) {
+	var content bytes.Buffer
+	csvContent := csv.NewWriter(&content)
+
+	if err := csvContent.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	for _, f := range Findings(r) {
+		record := []string{f.Section, f.Category, f.Scope, f.Resource, f.Group, strings.Join(f.Namespaces, ","), f.Object, f.Source, f.Destination, f.Confidence.String(), f.Message, r.Profile}
+		if err := csvContent.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	csvContent.Flush()
+	return content.Bytes(), csvContent.Error()
+}
+
+func (w csvWriter) FileName() string {
+	return csvFileName
+}
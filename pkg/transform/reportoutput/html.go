@@ -0,0 +1,109 @@
+package reportoutput
+
+//go:generate go run -tags=dev assets_generate.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"io/ioutil"
+	"sort"
+
+	"github.com/gildub/phronetic/pkg/transform/cluster"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+)
+
+const htmlTemplateName = "report.html"
+
+type htmlWriter struct{}
+
+func (w htmlWriter) Render(r ReportOutput) ([]byte, error) {
+	return renderHTML(r)
+}
+
+func (w htmlWriter) FileName() string {
+	return htmlFileName
+}
+
+func renderHTML(r ReportOutput) ([]byte, error) {
+	templateFile, err := Assets.Open(htmlTemplateName)
+	if err != nil {
+		return nil, err
+	}
+	defer templateFile.Close()
+
+	templateContent, err := ioutil.ReadAll(templateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var htmlTemplate *template.Template
+	htmlTemplate, err = template.New(htmlTemplateName).Funcs(template.FuncMap{
+		"byNamespace": resourcesByNamespace,
+		"section": func(section Section) (template.HTML, error) {
+			return renderSection(htmlTemplate, section)
+		},
+		"toJSON": toJSON,
+	}).Parse(string(templateContent))
+	if err != nil {
+		return nil, err
+	}
+
+	var content bytes.Buffer
+	if err := htmlTemplate.Execute(&content, r); err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// renderSection renders a section with its "section/<name>" template,
+// sections without template are rendered as a table of findings when they have some, otherwise as JSON
+func renderSection(htmlTemplate *template.Template, section Section) (template.HTML, error) {
+	var content bytes.Buffer
+	var err error
+	if sectionTemplate := htmlTemplate.Lookup("section/" + section.Name); sectionTemplate != nil {
+		err = sectionTemplate.Execute(&content, section.Content)
+	} else if finder, ok := section.Content.(finding.Finder); ok {
+		err = htmlTemplate.ExecuteTemplate(&content, "findingsSection", findingsSection{Name: section.Name, Findings: finder.Findings()})
+	} else {
+		err = htmlTemplate.ExecuteTemplate(&content, "genericSection", section)
+	}
+	if err != nil {
+		return "", err
+	}
+	return template.HTML(content.String()), nil
+}
+
+// findingsSection holds the findings of a section rendered without its own template
+type findingsSection struct {
+	Name     string
+	Findings []finding.Finding
+}
+
+func toJSON(value interface{}) (string, error) {
+	content, err := json.MarshalIndent(value, "", " ")
+	return string(content), err
+}
+
+// NamespaceResources holds the resources found in a namespace
+type NamespaceResources struct {
+	Namespace string
+	Resources []cluster.ReportResource
+}
+
+// resourcesByNamespace breaks down resources per namespace, sorted by namespace name
+func resourcesByNamespace(resources []cluster.ReportResource) []NamespaceResources {
+	namespaces := map[string][]cluster.ReportResource{}
+	for _, resource := range resources {
+		for _, namespace := range resource.NamespaceList {
+			namespaces[namespace] = append(namespaces[namespace], resource)
+		}
+	}
+
+	list := []NamespaceResources{}
+	for namespace, namespaceResources := range namespaces {
+		list = append(list, NamespaceResources{Namespace: namespace, Resources: namespaceResources})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Namespace < list[j].Namespace })
+	return list
+}
@@ -0,0 +1,95 @@
+package reportoutput
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/transform/cluster"
+	"github.com/gildub/phronetic/pkg/transform/deprecation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderHTML(t *testing.T) {
+	reportJSON, err := ioutil.ReadFile("testdata/reportexample.json")
+	require.NoError(t, err)
+
+	report := &ReportOutput{}
+	err = json.Unmarshal(reportJSON, report)
+	require.NoError(t, err)
+	require.NoError(t, report.Add(Section{Name: "custom", Content: map[string]int{"count": 1}}))
+
+	content, err := renderHTML(*report)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "status", expected: "<p>Status: <span class=\"status-partial\">partial</span></p>"},
+		{name: "failed transforms", expected: "<tr><td>phronetic-check-labels</td><td>extract</td><td>timed out after 10m0s</td></tr>"},
+		{name: "migration section", expected: "<h2>Migration: cluster1-example-com:8443</h2>"},
+		{name: "unsupported resource", expected: "<summary>cronjobs <span class=\"confidence-None\">None</span></summary>"},
+		{name: "readiness", expected: "<summary>Readiness: <span class=\"confidence-None\">None</span></summary>"},
+		{name: "namespace readiness", expected: "<tr><td>app2</td><td class=\"confidence-None\">None</td><td>cronjobs.batch, ingresses.extensions</td></tr>"},
+		{name: "namespace usage", expected: "<tr><td>app2</td><td>3</td><td>admin, api, frontend</td></tr>"},
+		{name: "namespace section", expected: "<summary>app2</summary>"},
+		{name: "relocated resource", expected: "<summary>ingresses &rarr; networking.k8s.io/v1beta1 Ingress <span class=\"confidence-Moderate\">Moderate</span></summary>"},
+		{name: "core group", expected: "<tr><td>core</td><td>v1</td><td>Pod</td></tr>"},
+		{name: "errors", expected: "<li>unable to get namespace app4: namespaces &#34;app4&#34; not found</li>"},
+		{name: "skipped transforms", expected: "<tr><td>CRD</td><td>it requires a live source cluster</td></tr>"},
+		{name: "differential section", expected: "<h2>Differential: cluster1-example-com:8443 / cluster2-example-com:6443</h2>"},
+		{name: "differential namespaces", expected: "<summary>Source objects in namespaces: app1, app3</summary>"},
+		{name: "differential namespace usage", expected: "<tr><td>app1</td><td>1</td><td>nightly</td></tr>"},
+		{name: "discovery errors", expected: "<tr><td>Destination</td><td>metrics.k8s.io/v1beta1</td><td>the server is currently unable to handle the request</td></tr>"},
+		{name: "deprecation section", expected: "<h2>Deprecated APIs: cluster1-example-com:8443 (1.11) &rarr; 1.16</h2>"},
+		{name: "removed API", expected: "<summary>deployments apps/v1beta1 Deployment <span class=\"confidence-None\">removed</span></summary>"},
+		{name: "deprecated API", expected: "<p>Deprecated in 1.14, removed in 1.22, replace with networking.k8s.io/v1 Ingress</p>"},
+		{name: "crd section", expected: "<h2>Custom resource schemas: cluster1-example-com:8443 / cluster2-example-com:6443</h2>"},
+		{name: "crd schema change", expected: "<tr><td>spec.replicas</td><td>retyped</td><td>string</td><td>integer</td></tr>"},
+		{name: "crd invalid object", expected: "<tr><td>app1</td><td>orders</td><td>spec.engine: required field is missing, spec.replicas: must be integer, found string, spec.storageSize: unknown field is dropped</td></tr>"},
+		{name: "verification section", expected: "<summary>app1: 1 missing, 0 extra, 1 changed</summary>"},
+		{name: "verification errors", expected: "<li>services skipped on cluster2-example-com:6443: unable to list /v1, Resource=services in namespace app1: Forbidden</li>"},
+		{name: "section without template", expected: "<h2>custom</h2>\n  <pre>{\n &#34;count&#34;: 1\n}</pre>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, string(content), tc.expected)
+		})
+	}
+}
+
+func TestRenderHTMLProfile(t *testing.T) {
+	report := ReportOutput{}
+	require.NoError(t, report.Add(
+		Section{Name: cluster.DiffSectionName, Content: cluster.ReportDiff{
+			ReportSrcCluster: cluster.ReportCluster{ClusterName: "cluster1-example-com:8443"},
+			ReportDstCluster: cluster.ReportCluster{ClusterName: "openshift-4.9", Profile: "openshift-4.9"},
+		}},
+		Section{Name: deprecation.SectionName, Content: deprecation.ReportDeprecation{
+			ClusterName:   "cluster1-example-com:8443",
+			TargetVersion: deprecation.Version{Major: 1, Minor: 22},
+			TargetProfile: "openshift-4.9",
+		}},
+	))
+
+	content, err := renderHTML(report)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "differential marker", expected: "<p>Destination is the openshift-4.9 reference profile, not a real cluster.</p>"},
+		{name: "differential destination", expected: "<summary>Destination profile: openshift-4.9</summary>"},
+		{name: "deprecation marker", expected: "<p>Target version is the one of the openshift-4.9 reference profile, not of a real cluster.</p>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Contains(t, string(content), tc.expected)
+		})
+	}
+}
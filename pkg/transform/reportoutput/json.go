@@ -2,21 +2,18 @@ package reportoutput
 
 import (
 	"encoding/json"
-
-	"github.com/gildub/phronetic/pkg/io"
-	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 )
 
-func jsonOutput(r ReportOutput) {
-	jsonReports, err := json.MarshalIndent(r, "", " ")
-	if err != nil {
-		panic(errors.Wrap(err, "unable to marshal reports"))
-	}
+type jsonWriter struct{}
+
+func (w jsonWriter) Render(r ReportOutput) ([]byte, error) {
+	return json.MarshalIndent(r, "", " ")
+}
 
-	if err := io.WriteFile(jsonReports, jsonFileName); err != nil {
-		panic(errors.Wrapf(err, "unable to write to report file: %s", jsonFileName))
-	}
+func (w jsonWriter) FileName() string {
+	return jsonFileName
+}
 
-	logrus.Infof("Report:Added: %s", jsonFileName)
+func jsonOutput(r ReportOutput) error {
+	return writeReport(jsonWriter{}, r)
 }
@@ -0,0 +1,127 @@
+package reportoutput
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+)
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr,omitempty"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+type junitWriter struct{}
+
+// Render writes a test suite for each report section with a test case for each finding,
+// findings without high confidence are failures. Failed transforms are errors of a "Transforms" test suite,
+// skipped ones are skipped test cases. The reference profile standing for destination cluster is a property of every test suite.
+func (w junitWriter) Render(r ReportOutput) ([]byte, error) {
+	suites := junitTestSuites{}
+	suiteIndex := map[string]int{}
+
+	if len(r.Errors) > 0 || len(r.Skipped) > 0 {
+		suite := junitTestSuite{Name: "Transforms", Tests: len(r.Errors) + len(r.Skipped), Errors: len(r.Errors), Skipped: len(r.Skipped)}
+		for _, e := range r.Errors {
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      e.Transform,
+				ClassName: e.Phase,
+				Error:     &junitFailure{Message: e.Message, Type: e.Phase},
+			})
+		}
+		for _, skipped := range r.Skipped {
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      skipped.Transform,
+				ClassName: "skip",
+				Skipped:   &junitSkipped{Message: skipped.Reason},
+			})
+		}
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	for _, f := range Findings(r) {
+		index, ok := suiteIndex[f.Section]
+		if !ok {
+			index = len(suites.Suites)
+			suiteIndex[f.Section] = index
+			suites.Suites = append(suites.Suites, junitTestSuite{Name: f.Section})
+		}
+
+		suite := &suites.Suites[index]
+		suite.Tests++
+		testCase := junitTestCase{
+			Name:      junitTestCaseName(f.Scope, strings.Join(f.Namespaces, ","), f.Group, f.Resource, f.Object),
+			ClassName: f.Category,
+		}
+		if f.Confidence < api.HighConfidence {
+			suite.Failures++
+			testCase.Failure = &junitFailure{
+				Message: f.Message,
+				Type:    f.Category,
+				Content: fmt.Sprintf("confidence: %s\nsource: %s\ndestination: %s", f.Confidence, f.Source, f.Destination),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	if r.Profile != "" {
+		for i := range suites.Suites {
+			suites.Suites[i].Properties = []junitProperty{{Name: "profile", Value: r.Profile}}
+		}
+	}
+
+	content, err := xml.MarshalIndent(suites, "", " ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), content...), nil
+}
+
+func (w junitWriter) FileName() string {
+	return junitFileName
+}
+
+func junitTestCaseName(parts ...string) string {
+	name := []string{}
+	for _, part := range parts {
+		if part != "" {
+			name = append(name, part)
+		}
+	}
+	return strings.Join(name, "/")
+}
@@ -0,0 +1,70 @@
+package reportoutput
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type markdownWriter struct{}
+
+func (w markdownWriter) Render(r ReportOutput) ([]byte, error) {
+	var content bytes.Buffer
+	content.WriteString("# Phronetic report\n")
+	if r.Status != "" {
+		fmt.Fprintf(&content, "\nStatus: %s\n", r.Status)
+	}
+	if r.Profile != "" {
+		fmt.Fprintf(&content, "\nDestination is the %s reference profile, not a real cluster.\n", markdownEscape(r.Profile))
+	}
+
+	if len(r.Errors) > 0 {
+		content.WriteString("\n## Failed transforms\n\n")
+		content.WriteString("| Transform | Phase | Error |\n")
+		content.WriteString("|---|---|---|\n")
+		for _, e := range r.Errors {
+			fmt.Fprintf(&content, "| %s | %s | %s |\n", markdownEscape(e.Transform), e.Phase, markdownEscape(e.Message))
+		}
+	}
+
+	if len(r.Skipped) > 0 {
+		content.WriteString("\n## Skipped transforms\n\n")
+		content.WriteString("| Transform | Reason |\n")
+		content.WriteString("|---|---|\n")
+		for _, skipped := range r.Skipped {
+			fmt.Fprintf(&content, "| %s | %s |\n", markdownEscape(skipped.Transform), markdownEscape(skipped.Reason))
+		}
+	}
+
+	findings := Findings(r)
+	if len(findings) == 0 {
+		content.WriteString("\nNo findings.\n")
+		return content.Bytes(), nil
+	}
+
+	section, category := "", ""
+	for _, f := range findings {
+		if f.Section != section {
+			section, category = f.Section, ""
+			fmt.Fprintf(&content, "\n## %s\n", markdownEscape(section))
+		}
+		if f.Category != category {
+			category = f.Category
+			fmt.Fprintf(&content, "\n### %s\n\n", markdownEscape(category))
+			content.WriteString("| Scope | Resource | Group | Namespaces | Object | Source | Destination | Confidence | Message |\n")
+			content.WriteString("|---|---|---|---|---|---|---|---|---|\n")
+		}
+		fmt.Fprintf(&content, "| %s | %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			markdownEscape(f.Scope), markdownEscape(f.Resource), markdownEscape(f.Group), markdownEscape(strings.Join(f.Namespaces, ", ")),
+			markdownEscape(f.Object), markdownEscape(f.Source), markdownEscape(f.Destination), f.Confidence, markdownEscape(f.Message))
+	}
+	return content.Bytes(), nil
+}
+
+func (w markdownWriter) FileName() string {
+	return markdownFileName
+}
+
+func markdownEscape(value string) string {
+	return strings.Replace(strings.Replace(value, "|", "\\|", -1), "\n", " ", -1)
+}
@@ -1,20 +1,240 @@
 package reportoutput
 
 import (
-	"github.com/gildub/phronetic/pkg/transform/cluster"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// StatusComplete is the status of a report generated by all transforms
+	StatusComplete = "complete"
+	// StatusPartial is the status of a report missing sections of failed transforms
+	StatusPartial = "partial"
+	// StatusFailed is the status of a report whose transforms all failed
+	StatusFailed = "failed"
+)
+
+const (
+	// PhaseExtract is the phase collecting data from clusters
+	PhaseExtract = "extract"
+	// PhaseValidate is the phase validating extracted data
+	PhaseValidate = "validate"
+	// PhaseTransform is the phase generating report sections from extracted data
+	PhaseTransform = "transform"
 )
 
+// reservedNames are JSON report fields which can't be used as section names
+var reservedNames = map[string]bool{"status": true, "profile": true, "errors": true, "skipped": true}
+
 // ReportOutput holds a collection of reports to be written to file
 type ReportOutput struct {
-	MigOperatorReport cluster.ReportMigOperator `json:"migOperator,omitempty"`
-	DiffReport        cluster.ReportDiff        `json:"differential,omitempty"`
+	// Status tells whether the report is complete, partial or failed
+	Status string
+	// Profile is the reference profile standing for destination cluster, findings against it are approximate
+	Profile string
+	// Errors lists transforms which failed, their sections are missing from the report
+	Errors []TransformError
+	// Skipped lists transforms of checks which don't apply to the analysed clusters, they don't make the report partial
+	Skipped []SkippedTransform
+	// Sections are written in order, each one as a field of the JSON report
+	Sections []Section
+}
+
+// TransformError represents json data of a failed transform
+type TransformError struct {
+	Transform string `json:"transform"`
+	// Phase is the phase the transform failed in: extract, validate or transform
+	Phase   string `json:"phase"`
+	Message string `json:"message"`
+}
+
+// SkippedTransform represents json data of a transform which didn't run, its check requirements aren't met
+type SkippedTransform struct {
+	Transform string `json:"transform"`
+	Reason    string `json:"reason"`
 }
 
+// Section is a named report generated by a transform
+type Section struct {
+	// Name is unique in the report and is the key of the section in JSON and YAML reports, except reserved names
+	// "status", "profile", "errors" and "skipped",
+	// the section is rendered in HTML by the "section/<name>" template
+	Name    string
+	Content interface{}
+}
+
+var (
+	sectionTypesMutex sync.RWMutex
+	// sectionTypes are the types sections are decoded into, other sections are kept as raw JSON
+	sectionTypes = map[string]reflect.Type{}
+)
+
 var (
-	jsonFileName = "report.json"
+	jsonFileName     = "report.json"
+	htmlFileName     = "report.html"
+	yamlFileName     = "report.yaml"
+	markdownFileName = "report.md"
+	csvFileName      = "report.csv"
+	junitFileName    = "report-junit.xml"
 )
 
-// DumpReports creates OCDs files
-func DumpReports(r ReportOutput) {
-	jsonOutput(r)
+// RegisterSectionType makes sections named name decoded into the type of content when a report is read.
+// Transforms register the types of the sections they generate, see transform.Check Sections.
+// RegisterSectionType panics when the name is reserved or already registered with another type.
+func RegisterSectionType(name string, content interface{}) {
+	sectionTypesMutex.Lock()
+	defer sectionTypesMutex.Unlock()
+
+	if reservedNames[name] {
+		panic(fmt.Sprintf("reportoutput: RegisterSectionType called with reserved name %s", name))
+	}
+
+	sectionType := reflect.TypeOf(content)
+	if registered, ok := sectionTypes[name]; ok && registered != sectionType {
+		panic(fmt.Sprintf("reportoutput: RegisterSectionType called twice for section %s", name))
+	}
+	sectionTypes[name] = sectionType
+}
+
+func registeredSectionType(name string) (reflect.Type, bool) {
+	sectionTypesMutex.RLock()
+	defer sectionTypesMutex.RUnlock()
+
+	sectionType, ok := sectionTypes[name]
+	return sectionType, ok
+}
+
+// Add appends sections, nothing is added when a section name is reserved or already used in the report
+func (r *ReportOutput) Add(sections ...Section) error {
+	names := map[string]bool{}
+	for _, section := range r.Sections {
+		names[section.Name] = true
+	}
+
+	for _, section := range sections {
+		if reservedNames[section.Name] {
+			return errors.Errorf("report section name %q is reserved", section.Name)
+		}
+		if names[section.Name] {
+			return errors.Errorf("report section %q already exists", section.Name)
+		}
+		names[section.Name] = true
+	}
+
+	r.Sections = append(r.Sections, sections...)
+	return nil
+}
+
+// Section returns the content of a section, nil if the report doesn't have it
+func (r ReportOutput) Section(name string) interface{} {
+	for _, section := range r.Sections {
+		if section.Name == name {
+			return section.Content
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes status, profile, errors, skipped transforms then sections as fields of a JSON object, keeping sections order
+func (r ReportOutput) MarshalJSON() ([]byte, error) {
+	fields := []Section{}
+	if r.Status != "" {
+		fields = append(fields, Section{Name: "status", Content: r.Status})
+	}
+	if r.Profile != "" {
+		fields = append(fields, Section{Name: "profile", Content: r.Profile})
+	}
+	if len(r.Errors) > 0 {
+		fields = append(fields, Section{Name: "errors", Content: r.Errors})
+	}
+	if len(r.Skipped) > 0 {
+		fields = append(fields, Section{Name: "skipped", Content: r.Skipped})
+	}
+
+	var content bytes.Buffer
+	content.WriteByte('{')
+	for i, field := range append(fields, r.Sections...) {
+		if i > 0 {
+			content.WriteByte(',')
+		}
+
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(field.Content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to marshal report section %s", field.Name)
+		}
+
+		content.Write(name)
+		content.WriteByte(':')
+		content.Write(value)
+	}
+	content.WriteByte('}')
+	return content.Bytes(), nil
+}
+
+// UnmarshalJSON reads sections from the fields of a JSON object, keeping their order
+func (r *ReportOutput) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return errors.New("report must be a JSON object")
+	}
+
+	*r = ReportOutput{Sections: []Section{}}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		name := token.(string)
+
+		raw := json.RawMessage{}
+		if err := decoder.Decode(&raw); err != nil {
+			return errors.Wrapf(err, "unable to read report section %s", name)
+		}
+
+		switch name {
+		case "status":
+			if err := json.Unmarshal(raw, &r.Status); err != nil {
+				return errors.Wrap(err, "unable to read report status")
+			}
+			continue
+		case "profile":
+			if err := json.Unmarshal(raw, &r.Profile); err != nil {
+				return errors.Wrap(err, "unable to read report profile")
+			}
+			continue
+		case "errors":
+			if err := json.Unmarshal(raw, &r.Errors); err != nil {
+				return errors.Wrap(err, "unable to read report errors")
+			}
+			continue
+		case "skipped":
+			if err := json.Unmarshal(raw, &r.Skipped); err != nil {
+				return errors.Wrap(err, "unable to read report skipped transforms")
+			}
+			continue
+		}
+
+		sectionType, ok := registeredSectionType(name)
+		if !ok {
+			r.Sections = append(r.Sections, Section{Name: name, Content: raw})
+			continue
+		}
+
+		content := reflect.New(sectionType)
+		if err := json.Unmarshal(raw, content.Interface()); err != nil {
+			return errors.Wrapf(err, "unable to read report section %s", name)
+		}
+		r.Sections = append(r.Sections, Section{Name: name, Content: content.Elem().Interface()})
+	}
+	return nil
 }
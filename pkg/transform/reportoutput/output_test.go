@@ -0,0 +1,55 @@
+package reportoutput
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gildub/phronetic/pkg/transform/cluster"
+	"github.com/gildub/phronetic/pkg/transform/crd"
+	"github.com/gildub/phronetic/pkg/transform/deprecation"
+	"github.com/gildub/phronetic/pkg/transform/verification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Section types are registered by transform checks, test reports are decoded with the same types
+func init() {
+	RegisterSectionType(cluster.MigOperatorSectionName, cluster.ReportMigOperator{})
+	RegisterSectionType(cluster.DiffSectionName, cluster.ReportDiff{})
+	RegisterSectionType(crd.SectionName, crd.ReportCRD{})
+	RegisterSectionType(deprecation.SectionName, deprecation.ReportDeprecation{})
+	RegisterSectionType(verification.SectionName, verification.ReportVerification{})
+}
+
+func TestReportOutputSections(t *testing.T) {
+	report := ReportOutput{Status: StatusComplete, Profile: "openshift-4.6", Skipped: []SkippedTransform{{Transform: "CRD", Reason: "it requires a live source cluster"}}}
+	require.NoError(t, report.Add(
+		Section{Name: "custom", Content: map[string]int{"count": 1}},
+		Section{Name: verification.SectionName, Content: verification.ReportVerification{MigPlan: "plan1"}},
+	))
+	assert.EqualError(t, report.Add(Section{Name: "custom", Content: map[string]int{"count": 2}}), `report section "custom" already exists`)
+	assert.EqualError(t, report.Add(Section{Name: "other"}, Section{Name: "other"}), `report section "other" already exists`)
+	assert.EqualError(t, report.Add(Section{Name: "status"}), `report section name "status" is reserved`)
+	assert.EqualError(t, report.Add(Section{Name: "skipped"}), `report section name "skipped" is reserved`)
+
+	content, err := json.Marshal(report)
+	require.NoError(t, err)
+	assert.Equal(t, `{"status":"complete","profile":"openshift-4.6","skipped":[{"transform":"CRD","reason":"it requires a live source cluster"}],"custom":{"count":1},"verification":{"migPlan":"plan1"}}`, string(content))
+
+	decoded := ReportOutput{}
+	require.NoError(t, json.Unmarshal(content, &decoded))
+	assert.Equal(t, StatusComplete, decoded.Status)
+	assert.Equal(t, "openshift-4.6", decoded.Profile)
+	assert.Equal(t, report.Skipped, decoded.Skipped)
+	require.Len(t, decoded.Sections, 2)
+	assert.Equal(t, "custom", decoded.Sections[0].Name)
+	assert.Equal(t, json.RawMessage(`{"count":1}`), decoded.Sections[0].Content)
+	assert.Equal(t, verification.ReportVerification{MigPlan: "plan1"}, decoded.Section(verification.SectionName))
+	assert.Nil(t, decoded.Section("missing"))
+}
+
+func TestRegisterSectionType(t *testing.T) {
+	RegisterSectionType(verification.SectionName, verification.ReportVerification{})
+	assert.Panics(t, func() { RegisterSectionType(verification.SectionName, crd.ReportCRD{}) })
+	assert.Panics(t, func() { RegisterSectionType("errors", crd.ReportCRD{}) })
+}
@@ -0,0 +1,128 @@
+package reportoutput
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/io"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// DefaultFormats are report formats written when none is configured
+var DefaultFormats = []string{"json", "html"}
+
+// Writer renders a report in a given format
+type Writer interface {
+	// Render returns the report content
+	Render(r ReportOutput) ([]byte, error)
+	// FileName returns the name of the report file written into WorkDir
+	FileName() string
+}
+
+var writers = map[string]Writer{
+	"csv":      csvWriter{},
+	"html":     htmlWriter{},
+	"json":     jsonWriter{},
+	"junit":    junitWriter{},
+	"markdown": markdownWriter{},
+	"yaml":     yamlWriter{},
+}
+
+// Formats returns the names of available report formats
+func Formats() []string {
+	formats := make([]string, 0, len(writers))
+	for format := range writers {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+func init() {
+	env.AddValidator(validateFormats)
+}
+
+// DumpReports writes a report file for each configured format
+func DumpReports(r ReportOutput) error {
+	formats, unknown := configuredFormats(env.Config())
+	if len(unknown) > 0 {
+		return errors.New(unknownFormats(unknown))
+	}
+
+	for _, format := range formats {
+		if err := writeReport(writers[format], r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configuredFormats returns known and unknown formats from "Output" configuration,
+// values can be repeated or comma separated
+func configuredFormats(config *viper.Viper) (formats []string, unknown []string) {
+	for _, value := range config.GetStringSlice("Output") {
+		for _, format := range strings.Split(value, ",") {
+			if format = strings.ToLower(strings.TrimSpace(format)); format == "" {
+				continue
+			}
+
+			if _, ok := writers[format]; ok {
+				formats = append(formats, format)
+			} else {
+				unknown = append(unknown, format)
+			}
+		}
+	}
+
+	if len(formats) == 0 && len(unknown) == 0 {
+		return DefaultFormats, nil
+	}
+	return formats, unknown
+}
+
+// validateFormats checks configured report formats are available, before any analysis is run
+func validateFormats(config *viper.Viper) []string {
+	_, unknown := configuredFormats(config)
+	if len(unknown) == 0 {
+		return nil
+	}
+	return []string{unknownFormats(unknown)}
+}
+
+func unknownFormats(formats []string) string {
+	quoted := make([]string, 0, len(formats))
+	for _, format := range formats {
+		quoted = append(quoted, fmt.Sprintf("%q", format))
+	}
+	return fmt.Sprintf("Unknown report format %s, available formats: %s", strings.Join(quoted, ", "), strings.Join(Formats(), ", "))
+}
+
+func writeReport(w Writer, r ReportOutput) error {
+	content, err := w.Render(r)
+	if err != nil {
+		return errors.Wrapf(err, "unable to render report file: %s", w.FileName())
+	}
+
+	if err := io.WriteFile(content, w.FileName()); err != nil {
+		return errors.Wrapf(err, "unable to write to report file: %s", w.FileName())
+	}
+
+	logrus.Infof("Report:Added: %s", w.FileName())
+	return nil
+}
+
+// Findings flattens all report sections into findings, in sections order
+func Findings(r ReportOutput) []finding.Finding {
+	findings := []finding.Finding{}
+	for _, section := range r.Sections {
+		if finder, ok := section.Content.(finding.Finder); ok {
+			findings = append(findings, finder.Findings()...)
+		}
+	}
+	return findings
+}
@@ -0,0 +1,101 @@
+package reportoutput
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfiguredFormats(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   []string
+		expected []string
+		problems []string
+	}{
+		{name: "default formats", output: nil, expected: DefaultFormats},
+		{name: "repeated formats", output: []string{"yaml", "CSV"}, expected: []string{"yaml", "csv"}},
+		{name: "comma separated formats", output: []string{"markdown, junit"}, expected: []string{"markdown", "junit"}},
+		{
+			name:     "unknown formats",
+			output:   []string{"json,pdf", "docx"},
+			expected: []string{"json"},
+			problems: []string{`Unknown report format "pdf", "docx", available formats: csv, html, json, junit, markdown, yaml`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := viper.New()
+			config.Set("Output", tc.output)
+
+			formats, _ := configuredFormats(config)
+			assert.Equal(t, tc.expected, formats)
+			assert.Equal(t, tc.problems, validateFormats(config))
+		})
+	}
+}
+
+func TestWriters(t *testing.T) {
+	reportJSON, err := ioutil.ReadFile("testdata/reportexample.json")
+	require.NoError(t, err)
+
+	report := &ReportOutput{}
+	err = json.Unmarshal(reportJSON, report)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		format   string
+		expected []string
+	}{
+		{format: "yaml", expected: []string{"migOperator:", "clusterName: cluster1-example-com:8443"}},
+		{format: "csv", expected: []string{"Section,Category,Scope", "Migration,Unsupported resource,Namespaced,cronjobs,batch,\"app1,app2\",,batch/v2alpha1 CronJob,batch/v1beta1 CronJob,None,"}},
+		{format: "markdown", expected: []string{"Status: partial", "| phronetic-check-labels | extract | timed out after 10m0s |", "## Skipped transforms", "| CRD | it requires a live source cluster |", "## Migration", "### Unsupported resource", "## Verification"}},
+		{format: "junit", expected: []string{"<testsuite name=\"Migration\"", "<testsuite name=\"Verification\"", "<failure ", "<testsuite name=\"Transforms\" tests=\"2\" failures=\"0\" errors=\"1\" skipped=\"1\">", "<skipped message=\"it requires a live source cluster\"></skipped>"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			content, err := writers[tc.format].Render(*report)
+			require.NoError(t, err)
+			for _, expected := range tc.expected {
+				assert.Contains(t, string(content), expected)
+			}
+		})
+	}
+}
+
+func TestWritersProfile(t *testing.T) {
+	reportJSON, err := ioutil.ReadFile("testdata/reportexample.json")
+	require.NoError(t, err)
+
+	report := &ReportOutput{}
+	require.NoError(t, json.Unmarshal(reportJSON, report))
+	report.Profile = "openshift-4.6"
+
+	testCases := []struct {
+		format   string
+		expected []string
+	}{
+		{format: "json", expected: []string{`"status": "partial",` + "\n" + ` "profile": "openshift-4.6",`}},
+		{format: "yaml", expected: []string{"profile: openshift-4.6"}},
+		{format: "html", expected: []string{"<p>Destination is the openshift-4.6 reference profile, not a real cluster.</p>"}},
+		{format: "csv", expected: []string{"Confidence,Message,Profile", ",openshift-4.6\n"}},
+		{format: "markdown", expected: []string{"Destination is the openshift-4.6 reference profile, not a real cluster."}},
+		{format: "junit", expected: []string{"<properties>\n   <property name=\"profile\" value=\"openshift-4.6\"></property>\n  </properties>"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			content, err := writers[tc.format].Render(*report)
+			require.NoError(t, err)
+			for _, expected := range tc.expected {
+				assert.Contains(t, string(content), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package reportoutput
+
+import (
+	"github.com/ghodss/yaml"
+)
+
+type yamlWriter struct{}
+
+func (w yamlWriter) Render(r ReportOutput) ([]byte, error) {
+	return yaml.Marshal(r)
+}
+
+func (w yamlWriter) FileName() string {
+	return yamlFileName
+}
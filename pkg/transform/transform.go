@@ -1,8 +1,19 @@
 package transform
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/ghodss/yaml"
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/gildub/phronetic/pkg/transform/verification"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
@@ -10,13 +21,13 @@ import (
 
 const (
 	// NoConfidence represents report items we can not migrate
-	NoConfidence = iota
+	NoConfidence = api.NoConfidence
 
 	// ModerateConfidence represents report items we can migrate with caveats
-	ModerateConfidence
+	ModerateConfidence = api.ModerateConfidence
 
 	// HighConfidence represents report items we can migrate without issue
-	HighConfidence
+	HighConfidence = api.HighConfidence
 
 	// OCP4InstallMsg message about using generated manifests
 	OCP4InstallMsg = `To install OCP4 run the installer as follow in order to add CRDs:
@@ -27,9 +38,6 @@ const (
 './openshift-install --dir $INSTALL_DIR  create cluster'`
 )
 
-// FinalReportOutput represents final output
-var FinalReportOutput Report
-
 // Cluster contains a cluster
 type Cluster struct {
 	Master Master
@@ -48,80 +56,261 @@ type Manifest struct {
 	CRD  []byte
 }
 
+const (
+	// DefaultWorkers is the number of transforms extracted concurrently when not configured
+	DefaultWorkers = 4
+
+	// DefaultTimeout is the time limit of each transform used by the command line
+	DefaultTimeout = 10 * time.Minute
+)
+
 // Runner a generic transform runner
 type Runner struct {
+	// Workers is the maximum number of transforms extracted concurrently
+	Workers int
+	// Timeout bounds the extraction of each transform, there is no timeout when zero
+	Timeout time.Duration
 }
 
 // Extraction is a generic data extraction
 type Extraction interface {
-	Transform() ([]Output, error)
+	// Transform returns the report sections generated from the extracted data
+	Transform() ([]reportoutput.Section, error)
 	Validate() error
 }
 
 // Transform is a generic transform
 type Transform interface {
-	Extract() (Extraction, error)
+	// Extract collects data, it should stop early once ctx is done.
+	// It must only read the session, transforms are extracted concurrently.
+	Extract(ctx context.Context) (Extraction, error)
 	Name() string
 }
 
-// Output is a generic output type
+// Output is a generic output type, such as the assembled report flushed to disk
 type Output interface {
 	Flush() error
 }
 
-//Start generating manifests to be used with Openshift 4
-func Start() {
+// Start runs the selected checks and writes the report, an error is returned when the report can't be written
+// or when no check succeeded
+func Start(session *api.Session) error {
 	logrus.Info("Starting analysis")
-	runner := NewRunner()
 
-	runner.Transform([]Transform{
-		ClusterTransform{},
-	})
-}
+	rules := verification.Rules{}
+	if err := env.Config().UnmarshalKey("Rules", &rules); err != nil {
+		return errors.Wrap(err, "Can't read normalization rules")
+	}
 
-// Transform is the process run to complete a transform
-func (r Runner) Transform(transforms []Transform) {
-	logrus.Debug("TransformRunner::Transform")
+	// Plugins are registered and checks names validated along with configuration
+	checks, err := SelectChecks(session.Mode, env.Config().GetStringSlice("Enable"), env.Config().GetStringSlice("Disable"))
+	if err != nil {
+		return err
+	}
 
-	// For each transform, extract the data, validate it, and run the transform.
-	// Handle any errors, and finally flush the output to it's desired destination
-	// NOTE: This should be parallelized with channels unless the transforms have
-	// some dependency on the outputs of others
-	for _, transform := range transforms {
-		logrus.Infof("Transform:Starting for - %s", transform.Name())
+	runner := NewRunner(env.Config().GetInt("Workers"), env.Config().GetDuration("TransformTimeout"))
+	config := CheckConfig{
+		Session:        session,
+		Rules:          rules,
+		TargetVersion:  env.Config().GetString("TargetVersion"),
+		KubeConfigPath: api.KubeConfigPath,
+		OutputDir:      env.Config().GetString("WorkDir"),
+	}
+	report, err := runner.Analyze(context.Background(), config, checks)
+	if err != nil {
+		HandleError(err, "Analysis")
+	}
 
-		extraction, err := transform.Extract()
-		if err != nil {
-			HandleError(err, transform.Name())
+	if err := (Report{Report: report}).Flush(); err != nil {
+		return errors.Wrap(err, "Can't write report")
+	}
+
+	switch report.Status {
+	case reportoutput.StatusFailed:
+		return errors.New("Analysis failed, no check succeeded, see report errors")
+	case reportoutput.StatusPartial:
+		logrus.Warn("Finished analysis, report is partial, see report errors")
+		return nil
+	}
+
+	logrus.Info("Succesfully finished analysis")
+	return nil
+}
+
+// Analyze runs the transforms of checks and returns the generated report.
+// Checks whose requirements aren't met by the session are skipped, they're listed in the report
+// but don't make it partial since they don't apply to the analysed clusters.
+func (r Runner) Analyze(ctx context.Context, config CheckConfig, checks []Check) (reportoutput.ReportOutput, error) {
+	transforms := make([]Transform, 0, len(checks))
+	skipped := []reportoutput.SkippedTransform{}
+	for _, check := range checks {
+		transform := check.New(config)
+		if reason := check.skipReason(config.Session); reason != "" {
+			logrus.Infof("Check %s skipped, %s", check.Name, reason)
+			skipped = append(skipped, reportoutput.SkippedTransform{Transform: transform.Name(), Reason: reason})
 			continue
 		}
+		transforms = append(transforms, transform)
+	}
 
-		if err := extraction.Validate(); err != nil {
-			HandleError(err, transform.Name())
-			continue
+	report, err := r.Transform(ctx, transforms)
+	if config.Session.Destination != nil {
+		report.Profile = config.Session.Destination.Profile
+	}
+	if len(skipped) > 0 {
+		report.Skipped = skipped
+	}
+	return report, err
+}
+
+// skipReason returns why the check can't run against session, empty when it can
+func (c Check) skipReason(session *api.Session) string {
+	switch {
+	case c.RequiresDestination && session.Destination == nil:
+		return "it requires a destination cluster"
+	case c.RequiresLiveClusters && !session.Source.IsLive():
+		return "it requires a live source cluster"
+	case c.RequiresLiveClusters && session.Destination != nil && !session.Destination.IsLive():
+		return "it requires a live destination cluster"
+	}
+	return ""
+}
+
+// reportStatus returns the status of a report whose failed transforms are out of total
+func reportStatus(failed, total int) string {
+	switch {
+	case failed == 0:
+		return reportoutput.StatusComplete
+	case failed == total:
+		return reportoutput.StatusFailed
+	}
+	return reportoutput.StatusPartial
+}
+
+// extractionResult holds the outcome of a transform extraction
+type extractionResult struct {
+	extraction Extraction
+	// phase is the phase err occurred in
+	phase string
+	err   error
+}
+
+// Transform is the process run to complete a transform.
+// Transforms are extracted concurrently, then the report is assembled from their sections in transforms order
+// so it doesn't depend on extraction timing. A failing transform is skipped and recorded in the report errors,
+// its error is also returned along with the report assembled from the others.
+func (r Runner) Transform(ctx context.Context, transforms []Transform) (reportoutput.ReportOutput, error) {
+	logrus.Debug("TransformRunner::Transform")
+	results := r.extract(ctx, transforms)
+
+	report := reportoutput.ReportOutput{}
+	failures := []string{}
+	for i, transform := range transforms {
+		phase, err := results[i].phase, results[i].err
+		if err == nil {
+			phase = reportoutput.PhaseTransform
+			var sections []reportoutput.Section
+			if sections, err = results[i].extraction.Transform(); err == nil {
+				err = report.Add(sections...)
+			}
 		}
 
-		_, err = extraction.Transform()
 		if err != nil {
 			HandleError(err, transform.Name())
-			continue
+			report.Errors = append(report.Errors, reportoutput.TransformError{
+				Transform: transform.Name(),
+				Phase:     phase,
+				Message:   err.Error(),
+			})
+			failures = append(failures, fmt.Sprintf("%s: %s", transform.Name(), err))
 		}
 	}
 
-	err := FinalReportOutput.Flush()
-	if err != nil {
-		HandleError(err, "Report")
+	report.Status = reportStatus(len(failures), len(transforms))
+	if len(failures) > 0 {
+		return report, errors.Errorf("transforms failed: %s", strings.Join(failures, "; "))
 	}
+	return report, nil
+}
 
-	logrus.Info("Succesfully finished analysis")
+// extract extracts and validates transforms data using a pool of workers,
+// results are in transforms order
+func (r Runner) extract(ctx context.Context, transforms []Transform) []extractionResult {
+	results := make([]extractionResult, len(transforms))
+	indexes := make(chan int)
+
+	workers := r.Workers
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < len(transforms); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = r.extractOne(ctx, transforms[i])
+			}
+		}()
+	}
+
+	for i := range transforms {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
 }
 
-// NewRunner creates a new Runner
-func NewRunner() *Runner {
-	return &Runner{}
+// extractOne extracts and validates data of a transform, giving up once ctx is done or the timeout expired.
+// A transform given up on keeps running in background until it notices its context is done,
+// its result is dropped and it can only read the session, which isn't modified once the analysis started.
+func (r Runner) extractOne(ctx context.Context, transform Transform) extractionResult {
+	if err := ctx.Err(); err != nil {
+		return extractionResult{phase: reportoutput.PhaseExtract, err: err}
+	}
+
+	parent := ctx
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	logrus.Infof("Transform:Starting for - %s", transform.Name())
+	done := make(chan extractionResult, 1)
+	go func() {
+		extraction, err := transform.Extract(ctx)
+		if err != nil {
+			done <- extractionResult{phase: reportoutput.PhaseExtract, err: err}
+			return
+		}
+		done <- extractionResult{extraction: extraction, phase: reportoutput.PhaseValidate, err: extraction.Validate()}
+	}()
+
+	select {
+	case result := <-done:
+		return result
+	case <-ctx.Done():
+		if parent.Err() == nil {
+			return extractionResult{phase: reportoutput.PhaseExtract, err: errors.Errorf("timed out after %s", r.Timeout)}
+		}
+		return extractionResult{phase: reportoutput.PhaseExtract, err: ctx.Err()}
+	}
+}
+
+// NewRunner creates a new Runner extracting up to workers transforms concurrently,
+// each of them within timeout
+func NewRunner(workers int, timeout time.Duration) *Runner {
+	return &Runner{
+		Workers: workers,
+		Timeout: timeout,
+	}
 }
 
-// HandleError handles errors
+// HandleError logs errors of skipped transforms
 func HandleError(err error, transformType string) error {
 	logrus.Warnf("Skipping %s: %s\n", transformType, err)
 	return err
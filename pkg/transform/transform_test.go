@@ -1,14 +1,26 @@
 package transform
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/env"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 )
 
 func TestAllOtherCRGenYaml(t *testing.T) {
@@ -48,3 +60,220 @@ func TestAllOtherCRGenYaml(t *testing.T) {
 		})
 	}
 }
+
+// fakeTransform is extracted after delay, its report has a section named after it
+type fakeTransform struct {
+	name        string
+	delay       time.Duration
+	err         error
+	validateErr error
+}
+
+type fakeExtraction struct {
+	name        string
+	validateErr error
+}
+
+func (t fakeTransform) Extract(ctx context.Context) (Extraction, error) {
+	select {
+	case <-time.After(t.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return fakeExtraction{name: t.name, validateErr: t.validateErr}, t.err
+}
+
+func (t fakeTransform) Name() string { return t.name }
+
+func (e fakeExtraction) Transform() ([]reportoutput.Section, error) {
+	return []reportoutput.Section{{Name: e.name, Content: e.name}}, nil
+}
+
+func (e fakeExtraction) Validate() error { return e.validateErr }
+
+func TestRunnerTransform(t *testing.T) {
+	testCases := []struct {
+		name             string
+		runner           *Runner
+		transforms       []Transform
+		expectedSections []string
+		expectedStatus   string
+		expectedErrors   []reportoutput.TransformError
+		expectedError    string
+	}{
+		{
+			name:   "reports in transforms order",
+			runner: NewRunner(3, 0),
+			transforms: []Transform{
+				fakeTransform{name: "a", delay: 30 * time.Millisecond},
+				fakeTransform{name: "b", delay: 10 * time.Millisecond},
+				fakeTransform{name: "c"},
+			},
+			expectedSections: []string{"a", "b", "c"},
+			expectedStatus:   reportoutput.StatusComplete,
+		},
+		{
+			name:   "single worker",
+			runner: NewRunner(1, 0),
+			transforms: []Transform{
+				fakeTransform{name: "a", delay: 10 * time.Millisecond},
+				fakeTransform{name: "b"},
+			},
+			expectedSections: []string{"a", "b"},
+			expectedStatus:   reportoutput.StatusComplete,
+		},
+		{
+			name:   "failed transform is skipped",
+			runner: NewRunner(2, 0),
+			transforms: []Transform{
+				fakeTransform{name: "a", err: errors.New("broken")},
+				fakeTransform{name: "b"},
+			},
+			expectedSections: []string{"b"},
+			expectedStatus:   reportoutput.StatusPartial,
+			expectedErrors:   []reportoutput.TransformError{{Transform: "a", Phase: "extract", Message: "broken"}},
+			expectedError:    "transforms failed: a: broken",
+		},
+		{
+			name:   "all transforms failed",
+			runner: NewRunner(2, 0),
+			transforms: []Transform{
+				fakeTransform{name: "a", validateErr: errors.New("invalid")},
+				fakeTransform{name: "errors"},
+			},
+			expectedSections: []string{},
+			expectedStatus:   reportoutput.StatusFailed,
+			expectedErrors: []reportoutput.TransformError{
+				{Transform: "a", Phase: "validate", Message: "invalid"},
+				{Transform: "errors", Phase: "transform", Message: `report section name "errors" is reserved`},
+			},
+			expectedError: `transforms failed: a: invalid; errors: report section name "errors" is reserved`,
+		},
+		{
+			name:   "timed out transform is skipped",
+			runner: NewRunner(2, 20*time.Millisecond),
+			transforms: []Transform{
+				fakeTransform{name: "a", delay: time.Minute},
+				fakeTransform{name: "b"},
+			},
+			expectedSections: []string{"b"},
+			expectedStatus:   reportoutput.StatusPartial,
+			expectedErrors:   []reportoutput.TransformError{{Transform: "a", Phase: "extract", Message: "timed out after 20ms"}},
+			expectedError:    "transforms failed: a: timed out after 20ms",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			report, err := tc.runner.Transform(context.Background(), tc.transforms)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+			assert.Equal(t, tc.expectedSections, sectionNames(report))
+			assert.Equal(t, tc.expectedStatus, report.Status)
+			assert.Equal(t, tc.expectedErrors, report.Errors)
+		})
+	}
+}
+
+func TestRunnerTransformCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := NewRunner(2, time.Minute).Transform(ctx, []Transform{
+		fakeTransform{name: "a"},
+	})
+	assert.EqualError(t, err, "transforms failed: a: context canceled")
+	assert.Empty(t, report.Sections)
+	assert.Equal(t, reportoutput.StatusFailed, report.Status)
+}
+
+// TestRunnerTransformDiscover runs transforms discovering the same live cluster concurrently, run it with -race
+func TestRunnerTransformDiscover(t *testing.T) {
+	source, server := newLiveCluster(t, "source", map[string]string{
+		"/api":     `{"kind": "APIVersions", "versions": ["v1"]}`,
+		"/apis":    `{"kind": "APIGroupList", "apiVersion": "v1", "groups": []}`,
+		"/api/v1":  `{"kind": "APIResourceList", "groupVersion": "v1", "resources": [{"name": "pods", "namespaced": true, "kind": "Pod", "verbs": ["list"]}]}`,
+		"/version": `{"major": "1", "minor": "16", "gitVersion": "v1.16.0"}`,
+	})
+	defer server.Close()
+
+	session := &api.Session{
+		Mode:        "Differential",
+		Source:      source,
+		Destination: loadSnapshotCluster(t, "testdata/snapshot-dst.json"),
+	}
+
+	report, err := NewRunner(2, 0).Transform(context.Background(), []Transform{
+		ClusterTransform{Session: session},
+		DeprecationTransform{Session: session},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, reportoutput.StatusComplete, report.Status)
+	require.NotNil(t, source.Snapshot)
+	assert.Equal(t, "v1.16.0", source.Snapshot.ServerVersion)
+}
+
+func TestStart(t *testing.T) {
+	workDir, err := ioutil.TempDir("", "phronetic-start")
+	require.NoError(t, err)
+	defer os.RemoveAll(workDir)
+
+	env.Config().Set("WorkDir", workDir)
+	env.Config().Set("Output", []string{"json"})
+	defer env.Config().Set("WorkDir", nil)
+	defer env.Config().Set("Output", nil)
+
+	// Discovery fails on an unavailable cluster
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	unavailable, err := api.NewClusterFromConfig("unavailable", &rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		session       *api.Session
+		expectedError string
+	}{
+		{
+			name: "checks succeeded",
+			session: &api.Session{
+				Mode:        "Differential",
+				Source:      loadSnapshotCluster(t, "testdata/snapshot-src.json"),
+				Destination: loadSnapshotCluster(t, "testdata/snapshot-dst.json"),
+			},
+		},
+		{
+			name:          "all checks failed",
+			session:       &api.Session{Mode: "Differential", Source: unavailable, Destination: unavailable},
+			expectedError: "Analysis failed, no check succeeded, see report errors",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			os.Remove(filepath.Join(workDir, "report.json"))
+
+			err := Start(tc.session)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+			// The report is written either way
+			assert.FileExists(t, filepath.Join(workDir, "report.json"))
+		})
+	}
+}
+
+func sectionNames(report reportoutput.ReportOutput) []string {
+	names := []string{}
+	for _, section := range report.Sections {
+		names = append(names, section.Name)
+	}
+	return names
+}
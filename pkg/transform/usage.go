@@ -0,0 +1,97 @@
+package transform
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gildub/phronetic/pkg/api"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// usagePageSize is the number of objects listed per request when looking for namespace usage
+const usagePageSize = 500
+
+// usageLister looks for objects of resources in source cluster namespaces, errors are recorded with addError
+type usageLister struct {
+	source   *api.Cluster
+	addError func(error)
+}
+
+// getNamespaces returns names of namespaces, namespaces missing on source cluster are recorded as errors
+func (l usageLister) getNamespaces(namespaceNames []string) []string {
+	namespaces := []string{}
+	for _, namespaceName := range namespaceNames {
+		namespace, err := api.GetNamespace(l.source.Client, namespaceName)
+		if err != nil {
+			l.addError(err)
+			continue
+		}
+		namespaces = append(namespaces, namespace.Name)
+	}
+	return namespaces
+}
+
+// scopeNamespaces returns names of source namespaces selected by scope
+func (l usageLister) scopeNamespaces(scope api.NamespaceScope) []string {
+	if len(scope.Names) > 0 {
+		return l.getNamespaces(scope.Names)
+	}
+
+	namespaces, err := api.ListNamespaceNames(l.source.Client, scope.Selector)
+	if err != nil {
+		l.addError(err)
+		return []string{}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// sessionNamespaces returns the source namespaces objects are looked for in: MigPlan namespaces in Migration mode,
+// namespaces selected by the namespace scope in Differential mode, none when source cluster is a snapshot
+func (l usageLister) sessionNamespaces(session *api.Session) []string {
+	switch {
+	case l.source.Client == nil:
+		// Objects can't be listed from a snapshot
+		return nil
+	case session.MigPlan != nil:
+		return l.getNamespaces(session.MigPlan.GetSourceNamespaces())
+	case !session.NamespaceScope.IsEmpty():
+		return l.scopeNamespaces(session.NamespaceScope)
+	}
+	return nil
+}
+
+// usageOf returns the objects of the resource in each namespace having some.
+// Only objects metadata are listed, by pages. It stops listing once ctx is done.
+func (l usageLister) usageOf(ctx context.Context, namespaces []string, resource string, gvk schema.GroupVersionKind) []api.NamespaceUsage {
+	gvr := schema.GroupVersionResource{
+		Group:    gvk.Group,
+		Version:  gvk.Version,
+		Resource: resource,
+	}
+
+	client := l.source.MetadataClient
+	if client == nil {
+		client = l.source.DynClient
+	}
+
+	usage := []api.NamespaceUsage{}
+	for _, namespace := range namespaces {
+		if ctx.Err() != nil {
+			break
+		}
+
+		names, err := api.ListObjectNames(client, gvr, namespace, usagePageSize)
+		if err != nil {
+			l.addError(err)
+			continue
+		}
+
+		if len(names) > 0 {
+			sort.Strings(names)
+			usage = append(usage, api.NamespaceUsage{Namespace: namespace, Count: len(names), Objects: names})
+		}
+	}
+	return usage
+}
@@ -0,0 +1,62 @@
+package verification
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/finding"
+)
+
+const (
+	verificationSection = "Verification"
+
+	missingCategory = "Missing object"
+	extraCategory   = "Extra object"
+	changedCategory = "Changed object"
+)
+
+// categoryConfidence scores each category of findings:
+// - Missing object: object wasn't migrated
+// - Changed object: object was migrated with a different content
+// - Extra object: object only exists on destination, migrated objects aren't affected
+var categoryConfidence = map[string]api.Confidence{
+	missingCategory: api.NoConfidence,
+	changedCategory: api.ModerateConfidence,
+	extraCategory:   api.HighConfidence,
+}
+
+// Findings flattens verification report
+func (r ReportVerification) Findings() []finding.Finding {
+	findings := []finding.Finding{}
+	for _, namespace := range r.Namespaces {
+		for _, object := range namespace.Missing {
+			findings = append(findings, object.finding(missingCategory, namespace.Name, "Object is missing on destination"))
+		}
+		for _, object := range namespace.Extra {
+			findings = append(findings, object.finding(extraCategory, namespace.Name, "Object only exists on destination"))
+		}
+		for _, object := range namespace.Changed {
+			paths := make([]string, 0, len(object.Patch))
+			for _, operation := range object.Patch {
+				paths = append(paths, fmt.Sprintf("%s %s", operation.Operation, operation.Path))
+			}
+			findings = append(findings, object.finding(changedCategory, namespace.Name, strings.Join(paths, ", ")))
+		}
+	}
+	return findings
+}
+
+func (o ReportObject) finding(category, namespace, message string) finding.Finding {
+	return finding.Finding{
+		Section:    verificationSection,
+		Category:   category,
+		Scope:      finding.NamespacedScope,
+		Resource:   o.Kind,
+		Namespaces: []string{namespace},
+		Object:     o.Name,
+		Source:     o.APIVersion,
+		Confidence: categoryConfidence[category],
+		Message:    message,
+	}
+}
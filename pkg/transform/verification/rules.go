@@ -0,0 +1,298 @@
+package verification
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Rules holds normalization rules applied to objects before they are compared
+type Rules struct {
+	// DisableDefaults excludes built-in Kubernetes and OpenShift rules
+	DisableDefaults bool `mapstructure:"disableDefaults"`
+	// Ignore removes JSON paths from objects
+	Ignore []IgnoreRule `mapstructure:"ignore"`
+	// Skip excludes objects from comparison
+	Skip []SkipRule `mapstructure:"skip"`
+	// Rewrite replaces values of source objects, such as the registry hostname or a namespace name
+	Rewrite []RewriteRule `mapstructure:"rewrite"`
+}
+
+// Selector matches objects by API group and Kind, an empty field matches any value.
+// The legacy core group is matched using "core".
+type Selector struct {
+	Group string `mapstructure:"group"`
+	Kind  string `mapstructure:"kind"`
+}
+
+// IgnoreRule removes paths from matching objects
+// Paths are JSON pointers (RFC 6901) where each segment can be a glob pattern, for instance
+// "/metadata/annotations/velero.io~1*" or "/spec/template/spec/containers/*/image"
+type IgnoreRule struct {
+	Selector `mapstructure:",squash"`
+	Paths    []string `mapstructure:"paths"`
+}
+
+// SkipRule excludes matching objects whose value at Path matches Value glob pattern
+type SkipRule struct {
+	Selector `mapstructure:",squash"`
+	Path     string `mapstructure:"path"`
+	Value    string `mapstructure:"value"`
+}
+
+// RewriteRule replaces From with To in string values of matching source objects.
+// When no paths are provided all string values are rewritten.
+type RewriteRule struct {
+	Selector `mapstructure:",squash"`
+	Paths    []string `mapstructure:"paths"`
+	From     string   `mapstructure:"from"`
+	To       string   `mapstructure:"to"`
+}
+
+// DefaultRules are built-in rules for server populated Kubernetes and OpenShift fields
+var DefaultRules = Rules{
+	Ignore: []IgnoreRule{
+		{
+			Paths: []string{
+				"/metadata/uid",
+				"/metadata/resourceVersion",
+				"/metadata/creationTimestamp",
+				"/metadata/managedFields",
+				"/metadata/selfLink",
+				"/metadata/generation",
+				"/metadata/ownerReferences/*/uid",
+				"/metadata/annotations/kubectl.kubernetes.io~1last-applied-configuration",
+				"/metadata/annotations/deployment.kubernetes.io~1revision",
+				"/metadata/annotations/migration.openshift.io~1*",
+				"/metadata/labels/migration.openshift.io~1*",
+				"/metadata/labels/velero.io~1*",
+				"/status",
+			},
+		},
+		{Selector: Selector{Group: "core", Kind: "Service"}, Paths: []string{"/spec/clusterIP", "/spec/ports/*/nodePort"}},
+		{Selector: Selector{Group: "core", Kind: "ServiceAccount"}, Paths: []string{"/secrets", "/imagePullSecrets"}},
+		{
+			Selector: Selector{Group: "core", Kind: "PersistentVolumeClaim"},
+			Paths: []string{
+				"/spec/volumeName",
+				"/metadata/annotations/pv.kubernetes.io~1*",
+				"/metadata/annotations/volume.beta.kubernetes.io~1storage-provisioner",
+			},
+		},
+		{Selector: Selector{Group: "route.openshift.io", Kind: "Route"}, Paths: []string{"/spec/host", "/metadata/annotations/openshift.io~1host.generated"}},
+		{Selector: Selector{Group: "apps.openshift.io", Kind: "DeploymentConfig"}, Paths: []string{"/spec/triggers/*/imageChangeParams/lastTriggeredImage"}},
+		{Selector: Selector{Group: "build.openshift.io", Kind: "BuildConfig"}, Paths: []string{"/spec/triggers/*/imageChange/lastTriggeredImageID"}},
+		{
+			Selector: Selector{Group: "image.openshift.io", Kind: "ImageStream"},
+			Paths: []string{
+				"/spec/tags/*/generation",
+				"/metadata/annotations/openshift.io~1image.dockerRepositoryCheck",
+			},
+		},
+	},
+	Skip: []SkipRule{
+		{Selector: Selector{Group: "core", Kind: "Secret"}, Path: "/type", Value: "kubernetes.io/service-account-token"},
+		{Selector: Selector{Group: "core", Kind: "Secret"}, Path: "/metadata/annotations/openshift.io~1token-secret.name", Value: "*"},
+		{Selector: Selector{Group: "core", Kind: "Pod"}, Path: "/metadata/ownerReferences/*/controller", Value: "true"},
+		{Selector: Selector{Kind: "Event"}, Path: "/metadata/name", Value: "*"},
+	},
+}
+
+// NewRules returns custom rules completed with built-in rules unless they're disabled
+func NewRules(custom Rules) Rules {
+	if custom.DisableDefaults {
+		return custom
+	}
+
+	rules := Rules{}
+	rules.Ignore = append(append(rules.Ignore, DefaultRules.Ignore...), custom.Ignore...)
+	rules.Skip = append(append(rules.Skip, DefaultRules.Skip...), custom.Skip...)
+	rules.Rewrite = append(append(rules.Rewrite, DefaultRules.Rewrite...), custom.Rewrite...)
+	return rules
+}
+
+// ForNamespace returns rules for objects of source namespace src restored in destination namespace dst,
+// a rewrite of the namespace name is added when they differ
+func (r Rules) ForNamespace(src, dst string) Rules {
+	if src == dst {
+		return r
+	}
+
+	rules := r
+	rules.Rewrite = append(append([]RewriteRule{}, r.Rewrite...), RewriteRule{Paths: []string{"/metadata/namespace"}, From: src, To: dst})
+	return rules
+}
+
+// Normalize applies rules to objects: skipped objects are removed, ignored paths are deleted
+// and, when rewrite is true, values are rewritten. Objects are deep copied before being modified.
+func (r Rules) Normalize(objects []unstructured.Unstructured, rewrite bool) []unstructured.Unstructured {
+	normalized := []unstructured.Unstructured{}
+	for _, object := range objects {
+		if r.skip(object) {
+			continue
+		}
+
+		object = *object.DeepCopy()
+		for _, rule := range r.Ignore {
+			if rule.matches(object) {
+				for _, p := range rule.Paths {
+					removePath(object.Object, splitPath(p))
+				}
+			}
+		}
+
+		if rewrite {
+			for _, rule := range r.Rewrite {
+				if !rule.matches(object) || rule.From == "" {
+					continue
+				}
+				if len(rule.Paths) == 0 {
+					object.Object = rewriteValue(object.Object, rule.From, rule.To).(map[string]interface{})
+					continue
+				}
+				for _, p := range rule.Paths {
+					rewritePath(object.Object, splitPath(p), rule.From, rule.To)
+				}
+			}
+		}
+
+		normalized = append(normalized, object)
+	}
+	return normalized
+}
+
+func (r Rules) skip(object unstructured.Unstructured) bool {
+	for _, rule := range r.Skip {
+		if !rule.matches(object) {
+			continue
+		}
+		for _, value := range valuesAt(object.Object, splitPath(rule.Path)) {
+			if matched, _ := path.Match(rule.Value, fmt.Sprint(value)); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s Selector) matches(object unstructured.Unstructured) bool {
+	gvk := object.GroupVersionKind()
+	if s.Group != "" && s.Group != api.GroupKey(gvk.Group) {
+		return false
+	}
+	return s.Kind == "" || s.Kind == gvk.Kind
+}
+
+// splitPath splits a JSON pointer into unescaped segments
+func splitPath(pointer string) []string {
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.Replace(strings.Replace(segment, "~1", "/", -1), "~0", "~", -1)
+	}
+	return segments
+}
+
+func matchSegment(pattern, key string) bool {
+	matched, err := path.Match(pattern, key)
+	return err == nil && matched
+}
+
+// valuesAt returns all values matching path segments
+func valuesAt(value interface{}, segments []string) []interface{} {
+	if len(segments) == 0 {
+		return []interface{}{value}
+	}
+
+	values := []interface{}{}
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if matchSegment(segments[0], key) {
+				values = append(values, valuesAt(child, segments[1:])...)
+			}
+		}
+	case []interface{}:
+		for i, child := range typed {
+			if matchSegment(segments[0], strconv.Itoa(i)) {
+				values = append(values, valuesAt(child, segments[1:])...)
+			}
+		}
+	}
+	return values
+}
+
+// removePath deletes map entries matching path segments
+func removePath(value interface{}, segments []string) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if !matchSegment(segments[0], key) {
+				continue
+			}
+			if len(segments) == 1 {
+				delete(typed, key)
+			} else {
+				removePath(child, segments[1:])
+			}
+		}
+	case []interface{}:
+		if len(segments) == 1 {
+			return
+		}
+		for i, child := range typed {
+			if matchSegment(segments[0], strconv.Itoa(i)) {
+				removePath(child, segments[1:])
+			}
+		}
+	}
+}
+
+// rewritePath rewrites string values matching path segments
+func rewritePath(value interface{}, segments []string, from, to string) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			if !matchSegment(segments[0], key) {
+				continue
+			}
+			if len(segments) == 1 {
+				typed[key] = rewriteValue(child, from, to)
+			} else {
+				rewritePath(child, segments[1:], from, to)
+			}
+		}
+	case []interface{}:
+		for i, child := range typed {
+			if !matchSegment(segments[0], strconv.Itoa(i)) {
+				continue
+			}
+			if len(segments) == 1 {
+				typed[i] = rewriteValue(child, from, to)
+			} else {
+				rewritePath(child, segments[1:], from, to)
+			}
+		}
+	}
+}
+
+// rewriteValue replaces from with to in all string values
+func rewriteValue(value interface{}, from, to string) interface{} {
+	switch typed := value.(type) {
+	case string:
+		return strings.Replace(typed, from, to, -1)
+	case map[string]interface{}:
+		for key, child := range typed {
+			typed[key] = rewriteValue(child, from, to)
+		}
+	case []interface{}:
+		for i, child := range typed {
+			typed[i] = rewriteValue(child, from, to)
+		}
+	}
+	return value
+}
@@ -0,0 +1,148 @@
+package verification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNormalize(t *testing.T) {
+	deployment := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":              "frontend",
+				"namespace":         "old",
+				"uid":               "0d2b8e3a",
+				"resourceVersion":   "1234",
+				"creationTimestamp": "2019-12-20T10:00:00Z",
+				"labels": map[string]interface{}{
+					"app":                    "frontend",
+					"velero.io/restore-name": "restore-1",
+					"velero.io/backup-name":  "backup-1",
+					"app.kubernetes.io/part": "shop",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "frontend",
+								"image": "docker-registry.default.svc:5000/old/frontend:latest",
+							},
+						},
+					},
+				},
+			},
+			"status": map[string]interface{}{
+				"replicas": int64(1),
+			},
+		},
+	}
+
+	tokenSecret := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name": "default-token-x2k4l",
+			},
+			"type": "kubernetes.io/service-account-token",
+		},
+	}
+
+	expectedDeployment := func(namespace string) map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "frontend",
+				"namespace": namespace,
+				"labels": map[string]interface{}{
+					"app":                    "frontend",
+					"app.kubernetes.io/part": "shop",
+				},
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  "frontend",
+								"image": "image-registry.openshift-image-registry.svc:5000/old/frontend:latest",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	rules := NewRules(Rules{
+		Rewrite: []RewriteRule{
+			{
+				Selector: Selector{Group: "apps"},
+				Paths:    []string{"/spec/template/spec/containers/*/image"},
+				From:     "docker-registry.default.svc:5000",
+				To:       "image-registry.openshift-image-registry.svc:5000",
+			},
+		},
+	})
+
+	testCases := []struct {
+		name            string
+		rewrite         bool
+		dstNamespace    string
+		expectedObjects []unstructured.Unstructured
+	}{
+		{
+			name:         "normalize source objects",
+			rewrite:      true,
+			dstNamespace: "old",
+			expectedObjects: []unstructured.Unstructured{
+				{Object: expectedDeployment("old")},
+			},
+		},
+		{
+			name:         "normalize source objects of a namespace mapped to another name",
+			rewrite:      true,
+			dstNamespace: "new",
+			expectedObjects: []unstructured.Unstructured{
+				{Object: expectedDeployment("new")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := rules.ForNamespace("old", tc.dstNamespace).Normalize([]unstructured.Unstructured{deployment, tokenSecret}, tc.rewrite)
+			assert.Equal(t, tc.expectedObjects, objects)
+			// Original objects are left untouched
+			assert.Equal(t, "1234", deployment.GetResourceVersion())
+		})
+	}
+}
+
+func TestNewRulesDisableDefaults(t *testing.T) {
+	custom := Rules{
+		DisableDefaults: true,
+		Ignore:          []IgnoreRule{{Paths: []string{"/status"}}},
+	}
+	assert.Equal(t, custom, NewRules(custom))
+}
+
+func TestRulesForNamespace(t *testing.T) {
+	rules := Rules{Rewrite: []RewriteRule{{From: "registry.old", To: "registry.new"}}}
+
+	assert.Equal(t, rules, rules.ForNamespace("app1", "app1"))
+
+	renamed := rules.ForNamespace("app1", "app2")
+	assert.Equal(t, []RewriteRule{
+		{From: "registry.old", To: "registry.new"},
+		{Paths: []string{"/metadata/namespace"}, From: "app1", To: "app2"},
+	}, renamed.Rewrite)
+}
@@ -0,0 +1,163 @@
+package verification
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/appscode/jsonpatch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NamespaceObjects holds objects of a namespace on source cluster and of the namespace it's migrated to on destination cluster
+type NamespaceObjects struct {
+	Namespace string
+	// DestinationNamespace is the name of the namespace on destination cluster, the same as Namespace unless MigPlan maps it
+	DestinationNamespace string
+	Source               []unstructured.Unstructured
+	Destination          []unstructured.Unstructured
+}
+
+// SectionName is the report section name of Verification mode
+const SectionName = "verification"
+
+// ReportVerification represents json report of the verification of a run migration
+type ReportVerification struct {
+	MigPlan        string            `json:"migPlan,omitempty"`
+	SrcClusterName string            `json:"sourceClusterName,omitempty"`
+	DstClusterName string            `json:"destinationClusterName,omitempty"`
+	Namespaces     []ReportNamespace `json:"namespaces,omitempty"`
+	// Errors are resources and namespaces left out of the comparison
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ReportNamespace represents json data of objects differences within a namespace
+type ReportNamespace struct {
+	Name string `json:"name"`
+	// DestinationName is set when the namespace is migrated to another name
+	DestinationName string         `json:"destinationName,omitempty"`
+	Missing         []ReportObject `json:"missing,omitempty"`
+	Extra           []ReportObject `json:"extra,omitempty"`
+	Changed         []ReportObject `json:"changed,omitempty"`
+}
+
+// ReportObject represents json data of an object and its differences
+type ReportObject struct {
+	APIVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Name       string                `json:"name"`
+	Patch      []jsonpatch.Operation `json:"patch,omitempty"`
+}
+
+// GenVerificationReport inserts report values of objects differences for json output,
+// a namespace whose objects can't be compared is left out and recorded in report errors
+func GenVerificationReport(migPlan, srcClusterName, dstClusterName string, namespaces []NamespaceObjects) (verificationReport ReportVerification) {
+	logrus.Info("VerificationReport::Report")
+	verificationReport.MigPlan = migPlan
+	verificationReport.SrcClusterName = srcClusterName
+	verificationReport.DstClusterName = dstClusterName
+
+	for _, namespace := range namespaces {
+		namespaceReport, err := CompareObjects(namespace.Source, namespace.Destination)
+		if err != nil {
+			err = errors.Wrapf(err, "namespace %s skipped", namespace.Namespace)
+			logrus.Warn(err)
+			verificationReport.Errors = append(verificationReport.Errors, err.Error())
+			continue
+		}
+		namespaceReport.Name = namespace.Namespace
+		if namespace.DestinationNamespace != namespace.Namespace {
+			namespaceReport.DestinationName = namespace.DestinationNamespace
+		}
+		verificationReport.Namespaces = append(verificationReport.Namespaces, namespaceReport)
+	}
+	return
+}
+
+// CompareObjects pairs source and destination objects by group, Kind and name.
+// Objects only on source are missing, objects only on destination are extra
+// and paired objects which differ are changed, along with the JSON patch turning source into destination.
+func CompareObjects(src, dst []unstructured.Unstructured) (namespaceReport ReportNamespace, err error) {
+	dstObjects := make(map[string]unstructured.Unstructured)
+	for _, object := range dst {
+		dstObjects[objectKey(object)] = object
+	}
+
+	srcKeys := make(map[string]bool)
+	for _, srcObject := range src {
+		key := objectKey(srcObject)
+		srcKeys[key] = true
+
+		dstObject, ok := dstObjects[key]
+		if !ok {
+			namespaceReport.Missing = append(namespaceReport.Missing, newReportObject(srcObject))
+			continue
+		}
+
+		patch, err := createPatch(srcObject, dstObject)
+		if err != nil {
+			return namespaceReport, errors.Wrapf(err, "Can't compare %s", key)
+		}
+
+		if len(patch) > 0 {
+			changed := newReportObject(dstObject)
+			changed.Patch = patch
+			namespaceReport.Changed = append(namespaceReport.Changed, changed)
+		}
+	}
+
+	for _, dstObject := range dst {
+		if !srcKeys[objectKey(dstObject)] {
+			namespaceReport.Extra = append(namespaceReport.Extra, newReportObject(dstObject))
+		}
+	}
+
+	sortReportObjects(namespaceReport.Missing)
+	sortReportObjects(namespaceReport.Extra)
+	sortReportObjects(namespaceReport.Changed)
+	return
+}
+
+func createPatch(src, dst unstructured.Unstructured) ([]jsonpatch.Operation, error) {
+	srcJSON, err := json.Marshal(src.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	dstJSON, err := json.Marshal(dst.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := jsonpatch.CreatePatch(srcJSON, dstJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(jsonpatch.ByPath(patch))
+	return patch, nil
+}
+
+// objectKey identifies an object by group, Kind and name, the same object is served with any version of its group
+func objectKey(object unstructured.Unstructured) string {
+	return object.GroupVersionKind().GroupKind().String() + "/" + object.GetName()
+}
+
+func newReportObject(object unstructured.Unstructured) ReportObject {
+	return ReportObject{
+		APIVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Name:       object.GetName(),
+	}
+}
+
+func sortReportObjects(objects []ReportObject) {
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].Kind != objects[j].Kind {
+			return objects[i].Kind < objects[j].Kind
+		}
+		return objects[i].Name < objects[j].Name
+	})
+}
@@ -0,0 +1,81 @@
+package verification
+
+import (
+	"math"
+	"testing"
+
+	"github.com/appscode/jsonpatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newObject(apiVersion, kind, name string, spec map[string]interface{}) unstructured.Unstructured {
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "test",
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestCompareObjects(t *testing.T) {
+	src := []unstructured.Unstructured{
+		newObject("v1", "Service", "frontend", map[string]interface{}{"type": "ClusterIP"}),
+		newObject("apps/v1", "Deployment", "frontend", map[string]interface{}{"replicas": int64(2)}),
+		newObject("v1", "ConfigMap", "settings", nil),
+		newObject("extensions/v1beta1", "Ingress", "frontend", nil),
+	}
+	dst := []unstructured.Unstructured{
+		newObject("v1", "Service", "frontend", map[string]interface{}{"type": "ClusterIP"}),
+		newObject("apps/v1", "Deployment", "frontend", map[string]interface{}{"replicas": int64(1)}),
+		newObject("v1", "Secret", "generated", nil),
+		newObject("networking.k8s.io/v1beta1", "Ingress", "frontend", nil),
+	}
+
+	namespaceReport, err := CompareObjects(src, dst)
+	require.NoError(t, err)
+
+	// Objects are paired within their group
+	assert.Equal(t, []ReportObject{
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "settings"},
+		{APIVersion: "extensions/v1beta1", Kind: "Ingress", Name: "frontend"},
+	}, namespaceReport.Missing)
+	assert.Equal(t, []ReportObject{
+		{APIVersion: "networking.k8s.io/v1beta1", Kind: "Ingress", Name: "frontend"},
+		{APIVersion: "v1", Kind: "Secret", Name: "generated"},
+	}, namespaceReport.Extra)
+	assert.Equal(t, []ReportObject{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "frontend",
+			Patch: []jsonpatch.Operation{
+				{Operation: "replace", Path: "/spec/replicas", Value: float64(1)},
+			},
+		},
+	}, namespaceReport.Changed)
+}
+
+func TestGenVerificationReport(t *testing.T) {
+	service := newObject("v1", "Service", "frontend", map[string]interface{}{"type": "ClusterIP"})
+	// NaN can't be marshalled, objects can't be compared
+	invalid := newObject("v1", "ConfigMap", "settings", map[string]interface{}{"ratio": math.NaN()})
+
+	report := GenVerificationReport("plan1", "source", "destination", []NamespaceObjects{
+		{Namespace: "app1", DestinationNamespace: "app1", Source: []unstructured.Unstructured{service}},
+		{Namespace: "app2", DestinationNamespace: "app2", Source: []unstructured.Unstructured{invalid}, Destination: []unstructured.Unstructured{invalid}},
+	})
+
+	assert.Equal(t, []ReportNamespace{
+		{Name: "app1", Missing: []ReportObject{{APIVersion: "v1", Kind: "Service", Name: "frontend"}}},
+	}, report.Namespaces)
+	require.Len(t, report.Errors, 1)
+	assert.Contains(t, report.Errors[0], "namespace app2 skipped: Can't compare ConfigMap/settings: json: unsupported value: NaN")
+}
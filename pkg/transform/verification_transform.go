@@ -0,0 +1,180 @@
+package transform
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/reportoutput"
+	"github.com/gildub/phronetic/pkg/transform/verification"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VerificationTransformName is the verification report name
+const VerificationTransformName = "Verification"
+
+// VerificationExtraction holds objects extracted from MigPlan namespaces on both clusters
+type VerificationExtraction struct {
+	MigPlan        string
+	SrcClusterName string
+	DstClusterName string
+	Namespaces     []verification.NamespaceObjects
+	// Errors are resources which couldn't be listed, they're left out of the comparison
+	Errors []string
+}
+
+// VerificationTransform reprents transform comparing objects after a migration has run
+type VerificationTransform struct {
+	Session *api.Session
+	// Rules are custom normalization rules, added to default ones
+	Rules verification.Rules
+}
+
+// verifiedResource holds GVRs used to list a resource on each cluster
+type verifiedResource struct {
+	Src schema.GroupVersionResource
+	Dst schema.GroupVersionResource
+	// DstServed is false when destination doesn't serve the resource at all
+	DstServed bool
+}
+
+// Transform converts the retrieved information to a useful output
+func (e VerificationExtraction) Transform() ([]reportoutput.Section, error) {
+	logrus.Info("VerificationTransform::Transform:Reports")
+
+	report := verification.GenVerificationReport(e.MigPlan, e.SrcClusterName, e.DstClusterName, e.Namespaces)
+	report.Errors = append(append([]string{}, e.Errors...), report.Errors...)
+
+	return []reportoutput.Section{{Name: verification.SectionName, Content: report}}, nil
+}
+
+// Validate no need to validate it, data is exctracted from API
+func (e VerificationExtraction) Validate() (err error) { return }
+
+// Extract lists objects of every MigPlan namespace on source cluster and of the namespace
+// it's mapped to on destination cluster, MigPlan namespaces are either "name" or "source:destination"
+func (e VerificationTransform) Extract(ctx context.Context) (Extraction, error) {
+	src, dst := e.Session.Source, e.Session.Destination
+	extraction := &VerificationExtraction{
+		MigPlan:        e.Session.MigPlan.Name,
+		SrcClusterName: src.Name,
+		DstClusterName: dst.Name,
+	}
+
+	srcSnapshot, err := src.Discover()
+	if err != nil {
+		return nil, err
+	}
+
+	dstSnapshot, err := dst.Discover()
+	if err != nil {
+		return nil, err
+	}
+
+	rules := verification.NewRules(e.Rules)
+
+	resources := listVerifiedResources(srcSnapshot, dstSnapshot)
+
+	dstNamespaces := e.Session.MigPlan.GetDestinationNamespaces()
+	for i, namespace := range e.Session.MigPlan.GetSourceNamespaces() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		namespaceObjects := verification.NamespaceObjects{Namespace: namespace, DestinationNamespace: dstNamespaces[i]}
+
+		// A resource which can't be listed on either cluster is skipped on both, its objects would be reported missing or extra
+		for _, resource := range resources {
+			srcObjects, err := api.ListObjects(src.DynClient, resource.Src, namespace, usagePageSize)
+			if err != nil {
+				extraction.addError(errors.Wrapf(err, "%s skipped on %s", resource.Src.Resource, src.Name))
+				continue
+			}
+
+			if resource.DstServed {
+				dstObjects, err := api.ListObjects(dst.DynClient, resource.Dst, namespaceObjects.DestinationNamespace, usagePageSize)
+				if err != nil {
+					extraction.addError(errors.Wrapf(err, "%s skipped on %s", resource.Src.Resource, dst.Name))
+					continue
+				}
+				namespaceObjects.Destination = append(namespaceObjects.Destination, dstObjects...)
+			}
+			namespaceObjects.Source = append(namespaceObjects.Source, srcObjects...)
+		}
+
+		// Rewrites turn source values into their expected destination values, including the namespace name
+		namespaceRules := rules.ForNamespace(namespace, namespaceObjects.DestinationNamespace)
+		namespaceObjects.Source = namespaceRules.Normalize(namespaceObjects.Source, true)
+		namespaceObjects.Destination = namespaceRules.Normalize(namespaceObjects.Destination, false)
+		extraction.Namespaces = append(extraction.Namespaces, namespaceObjects)
+	}
+
+	return *extraction, nil
+}
+
+// listVerifiedResources returns source namespaced resources which can be listed,
+// with the GVR to use on each cluster. Each resource is listed once using the highest priority group,
+// the same group is used on destination when available so objects can be paired.
+// Objects of a resource served by destination within another group can't be paired, they're reported missing and extra.
+func listVerifiedResources(srcSnapshot, dstSnapshot *api.Snapshot) []verifiedResource {
+	srcRESTMapper := srcSnapshot.RESTMapper()
+	dstRESTMapper := dstSnapshot.RESTMapper()
+
+	names := map[string]bool{}
+	for _, resourceList := range srcSnapshot.Resources {
+		for _, APIResource := range resourceList.APIResources {
+			if APIResource.Namespaced && !strings.Contains(APIResource.Name, "/") && hasVerb(APIResource, "list") {
+				names[APIResource.Name] = true
+			}
+		}
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	resources := []verifiedResource{}
+	for _, name := range sortedNames {
+		srcGVR, err := srcRESTMapper.ResourceFor(schema.GroupVersionResource{Resource: name})
+		if err != nil {
+			logrus.Debugf("Skipping resource %s: %s", name, err)
+			continue
+		}
+
+		resource := verifiedResource{Src: srcGVR}
+		if dstGVR, err := dstRESTMapper.ResourceFor(schema.GroupVersionResource{Group: srcGVR.Group, Resource: name}); err == nil {
+			resource.Dst, resource.DstServed = dstGVR, true
+		} else if dstGVR, err := dstRESTMapper.ResourceFor(schema.GroupVersionResource{Resource: name}); err == nil {
+			resource.Dst, resource.DstServed = dstGVR, true
+		}
+
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+func hasVerb(APIResource metav1.APIResource, verb string) bool {
+	for _, v := range APIResource.Verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *VerificationExtraction) addError(err error) {
+	logrus.Warn(err)
+	e.Errors = append(e.Errors, err.Error())
+}
+
+// Name returns a human readable name for the transform
+func (e VerificationTransform) Name() string {
+	return VerificationTransformName
+}
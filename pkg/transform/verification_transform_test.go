@@ -0,0 +1,85 @@
+package transform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/appscode/jsonpatch"
+	"github.com/gildub/phronetic/pkg/api"
+	"github.com/gildub/phronetic/pkg/transform/verification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	migv1alpha1 "github.com/fusor/mig-controller/pkg/apis/migration/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const verificationDiscovery = `{"kind": "APIResourceList", "groupVersion": "v1", "resources": [
+  {"name": "configmaps", "namespaced": true, "kind": "ConfigMap", "verbs": ["list"]},
+  {"name": "services", "namespaced": true, "kind": "Service", "verbs": ["list"]}
+]}`
+
+func TestVerificationTransform(t *testing.T) {
+	source, srcServer := newLiveCluster(t, "source", map[string]string{
+		"/api":    `{"kind": "APIVersions", "versions": ["v1"]}`,
+		"/apis":   `{"kind": "APIGroupList", "apiVersion": "v1", "groups": []}`,
+		"/api/v1": verificationDiscovery,
+		"/api/v1/namespaces/app1/configmaps": `{"kind": "ConfigMapList", "apiVersion": "v1", "metadata": {"continue": "2"}, "items": [
+		  {"kind": "ConfigMap", "apiVersion": "v1", "metadata": {"name": "settings", "namespace": "app1"}, "data": {"debug": "false"}}
+		]}`,
+		"/api/v1/namespaces/app1/configmaps?continue=2": `{"kind": "ConfigMapList", "apiVersion": "v1", "metadata": {}, "items": [
+		  {"kind": "ConfigMap", "apiVersion": "v1", "metadata": {"name": "features", "namespace": "app1"}}
+		]}`,
+		"/api/v1/namespaces/app1/services": `{"kind": "ServiceList", "apiVersion": "v1", "metadata": {}, "items": [
+		  {"kind": "Service", "apiVersion": "v1", "metadata": {"name": "frontend", "namespace": "app1"}}
+		]}`,
+	})
+	defer srcServer.Close()
+
+	// Services can't be listed on destination
+	destination, dstServer := newLiveCluster(t, "destination", map[string]string{
+		"/api":    `{"kind": "APIVersions", "versions": ["v1"]}`,
+		"/apis":   `{"kind": "APIGroupList", "apiVersion": "v1", "groups": []}`,
+		"/api/v1": verificationDiscovery,
+		"/api/v1/namespaces/app2/configmaps": `{"kind": "ConfigMapList", "apiVersion": "v1", "metadata": {}, "items": [
+		  {"kind": "ConfigMap", "apiVersion": "v1", "metadata": {"name": "settings", "namespace": "app2"}, "data": {"debug": "true"}}
+		]}`,
+	})
+	defer dstServer.Close()
+
+	session := &api.Session{
+		Mode:        "Verification",
+		Source:      source,
+		Destination: destination,
+		MigPlan: &migv1alpha1.MigPlan{
+			ObjectMeta: metav1.ObjectMeta{Name: "plan1"},
+			Spec:       migv1alpha1.MigPlanSpec{Namespaces: []string{"app1:app2"}},
+		},
+	}
+
+	extraction, err := VerificationTransform{Session: session}.Extract(context.Background())
+	require.NoError(t, err)
+
+	sections, err := extraction.Transform()
+	require.NoError(t, err)
+	require.Len(t, sections, 1)
+
+	report := sections[0].Content.(verification.ReportVerification)
+	require.Len(t, report.Errors, 1)
+	assert.Contains(t, report.Errors[0], "services skipped on destination: unable to list /v1, Resource=services in namespace app2")
+	assert.Equal(t, []verification.ReportNamespace{
+		{
+			Name:            "app1",
+			DestinationName: "app2",
+			Missing:         []verification.ReportObject{{APIVersion: "v1", Kind: "ConfigMap", Name: "features"}},
+			Changed: []verification.ReportObject{
+				{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       "settings",
+					Patch:      []jsonpatch.Operation{{Operation: "replace", Path: "/data/debug", Value: "true"}},
+				},
+			},
+		},
+	}, report.Namespaces)
+}